@@ -3,6 +3,7 @@ package pgtype
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"reflect"
@@ -87,6 +88,12 @@ type UntypedTextArray struct {
 }
 
 func ParseUntypedTextArray(src string) (*UntypedTextArray, error) {
+	return parseUntypedTextArray(src, ',')
+}
+
+// parseUntypedTextArray parses a text format array whose elements are separated by delim. Most types use ',' but
+// some, such as box and the PostGIS types, use another delimiter.
+func parseUntypedTextArray(src string, delim byte) (*UntypedTextArray, error) {
 	dst := &UntypedTextArray{}
 
 	buf := bytes.NewBufferString(src)
@@ -189,7 +196,7 @@ func ParseUntypedTextArray(src string) (*UntypedTextArray, error) {
 				implicitDimensions[currentDim].Length++
 			}
 			currentDim++
-		case ',':
+		case rune(delim):
 		case '}':
 			currentDim--
 			if currentDim < counterDim {
@@ -197,7 +204,7 @@ func ParseUntypedTextArray(src string) (*UntypedTextArray, error) {
 			}
 		default:
 			buf.UnreadRune()
-			value, quoted, err := arrayParseValue(buf)
+			value, quoted, err := arrayParseValue(buf, delim)
 			if err != nil {
 				return nil, fmt.Errorf("invalid array value: %v", err)
 			}
@@ -241,7 +248,7 @@ func skipWhitespace(buf *bytes.Buffer) {
 	}
 }
 
-func arrayParseValue(buf *bytes.Buffer) (string, bool, error) {
+func arrayParseValue(buf *bytes.Buffer, delim byte) (string, bool, error) {
 	r, _, err := buf.ReadRune()
 	if err != nil {
 		return "", false, err
@@ -260,7 +267,7 @@ func arrayParseValue(buf *bytes.Buffer) (string, bool, error) {
 		}
 
 		switch r {
-		case ',', '}':
+		case rune(delim), '}':
 			buf.UnreadRune()
 			return s.String(), false, nil
 		}
@@ -353,7 +360,11 @@ func isSpace(ch byte) bool {
 }
 
 func QuoteArrayElementIfNeeded(src string) string {
-	if src == "" || (len(src) == 4 && strings.ToLower(src) == "null") || isSpace(src[0]) || isSpace(src[len(src)-1]) || strings.ContainsAny(src, `{},"\`) {
+	return quoteArrayElementIfNeeded(src, ',')
+}
+
+func quoteArrayElementIfNeeded(src string, delim byte) string {
+	if src == "" || (len(src) == 4 && strings.ToLower(src) == "null") || isSpace(src[0]) || isSpace(src[len(src)-1]) || strings.ContainsAny(src, `{}"\`) || strings.IndexByte(src, delim) != -1 {
 		return quoteArrayElement(src)
 	}
 	return src
@@ -379,3 +390,710 @@ func findDimensionsFromValue(value reflect.Value, dimensions []ArrayDimension, e
 	}
 	return dimensions, elementsLength, true
 }
+
+// ArrayBinaryScanner iterates over the elements of a binary encoded array without decoding the whole array. Element
+// bytes are slices of the source and are only valid until the source is modified.
+type ArrayBinaryScanner struct {
+	ci  *ConnInfo
+	rp  int
+	src []byte
+
+	header       ArrayHeader
+	elementCount int
+	elementIdx   int
+	elementBytes []byte
+	err          error
+}
+
+// NewArrayBinaryScanner returns a scanner over a binary encoded array value.
+func NewArrayBinaryScanner(ci *ConnInfo, src []byte) *ArrayBinaryScanner {
+	s := &ArrayBinaryScanner{ci: ci, src: src}
+
+	rp, err := s.header.DecodeBinary(ci, src)
+	if err != nil {
+		s.err = err
+		return s
+	}
+	s.rp = rp
+	s.elementCount = arrayElementCount(s.header.Dimensions)
+
+	return s
+}
+
+// Header returns the array header. Its Dimensions must not be modified.
+func (s *ArrayBinaryScanner) Header() ArrayHeader {
+	return s.header
+}
+
+// ElementCount returns the total number of elements in the array.
+func (s *ArrayBinaryScanner) ElementCount() int {
+	return s.elementCount
+}
+
+// ScanDecoder calls Next and decodes the result with d.
+func (s *ArrayBinaryScanner) ScanDecoder(d BinaryDecoder) {
+	if s.err != nil {
+		return
+	}
+
+	if s.Next() {
+		s.err = d.DecodeBinary(s.ci, s.elementBytes)
+	} else if s.err == nil {
+		s.err = errors.New("read past end of array")
+	}
+}
+
+// ScanValue calls Next and scans the result into d.
+func (s *ArrayBinaryScanner) ScanValue(d interface{}) {
+	if s.err != nil {
+		return
+	}
+
+	if s.Next() {
+		s.err = s.ci.Scan(uint32(s.header.ElementOID), BinaryFormatCode, s.elementBytes, d)
+	} else if s.err == nil {
+		s.err = errors.New("read past end of array")
+	}
+}
+
+// Next advances the scanner to the next element. It returns false after the last element is read or an error occurs.
+// After Next returns false, the Err method can be called to check if any errors occurred.
+func (s *ArrayBinaryScanner) Next() bool {
+	if s.err != nil || s.elementIdx == s.elementCount {
+		return false
+	}
+
+	if len(s.src[s.rp:]) < 4 {
+		s.err = fmt.Errorf("array incomplete at element %d", s.elementIdx)
+		return false
+	}
+	elemLen := int(int32(binary.BigEndian.Uint32(s.src[s.rp:])))
+	s.rp += 4
+
+	if elemLen >= 0 {
+		if len(s.src[s.rp:]) < elemLen {
+			s.err = fmt.Errorf("array incomplete at element %d", s.elementIdx)
+			return false
+		}
+		s.elementBytes = s.src[s.rp : s.rp+elemLen]
+		s.rp += elemLen
+	} else {
+		s.elementBytes = nil
+	}
+
+	s.elementIdx++
+	return true
+}
+
+// Bytes returns the bytes of the element most recently read by Next. It is nil for a NULL element.
+func (s *ArrayBinaryScanner) Bytes() []byte {
+	return s.elementBytes
+}
+
+// Err returns any error encountered by the scanner.
+func (s *ArrayBinaryScanner) Err() error {
+	return s.err
+}
+
+// ArrayTextScanner iterates over the elements of a text encoded array without decoding the whole array. Unquoted
+// element bytes are slices of the source. Quoted elements with escapes are unescaped into a buffer that is reused by
+// the next call to Next.
+type ArrayTextScanner struct {
+	ci    *ConnInfo
+	rp    int
+	src   []byte
+	delim byte
+
+	header       ArrayHeader
+	elementCount int
+	elementIdx   int
+	elementBytes []byte
+	buf          []byte
+	err          error
+}
+
+// NewArrayTextScanner returns a scanner over a text encoded array value. elementOID is used to scan elements with
+// ScanValue and may be 0 if it is unknown. The dimensions are found by a pass over src that does not allocate for
+// elements.
+func NewArrayTextScanner(ci *ConnInfo, elementOID uint32, src []byte) *ArrayTextScanner {
+	return NewArrayTextScannerWithDelimiter(ci, elementOID, ',', src)
+}
+
+// NewArrayTextScannerWithDelimiter returns a scanner like NewArrayTextScanner for an array whose elements are separated
+// by delimiter instead of ','. e.g. box uses ';'.
+func NewArrayTextScannerWithDelimiter(ci *ConnInfo, elementOID uint32, delimiter byte, src []byte) *ArrayTextScanner {
+	s := &ArrayTextScanner{ci: ci, src: src, delim: delimiter}
+	s.header.ElementOID = int32(elementOID)
+
+	rp := skipArrayTextSpace(src, 0)
+
+	var explicitDimensions []ArrayDimension
+	for rp < len(src) && src[rp] == '[' {
+		var lower, upper int32
+		var err error
+		lower, rp, err = parseArrayTextInteger(src, rp+1)
+		if err == nil {
+			if rp >= len(src) || src[rp] != ':' {
+				err = errors.New("expected ':'")
+			} else {
+				upper, rp, err = parseArrayTextInteger(src, rp+1)
+			}
+		}
+		if err == nil && (rp >= len(src) || src[rp] != ']') {
+			err = errors.New("expected ']'")
+		}
+		if err != nil {
+			s.err = fmt.Errorf("invalid array dimensions: %v", err)
+			return s
+		}
+		rp++
+		explicitDimensions = append(explicitDimensions, ArrayDimension{LowerBound: lower, Length: upper - lower + 1})
+	}
+	if len(explicitDimensions) > 0 {
+		if rp >= len(src) || src[rp] != '=' {
+			s.err = errors.New("invalid array dimensions: expected '='")
+			return s
+		}
+		rp = skipArrayTextSpace(src, rp+1)
+	}
+
+	if rp >= len(src) || src[rp] != '{' {
+		s.err = errors.New("invalid array, expected '{'")
+		return s
+	}
+	s.rp = rp
+
+	implicitDimensions, err := s.countElements()
+	if err != nil {
+		s.err = fmt.Errorf("invalid array: %v", err)
+		return s
+	}
+
+	if s.elementCount > 0 {
+		if len(explicitDimensions) > 0 {
+			s.header.Dimensions = explicitDimensions
+		} else {
+			s.header.Dimensions = implicitDimensions
+		}
+	}
+
+	return s
+}
+
+// countElements finds the element count, implicit dimensions and whether the array contains NULL in the same way as
+// ParseUntypedTextArray.
+func (s *ArrayTextScanner) countElements() ([]ArrayDimension, error) {
+	src := s.src
+	rp := s.rp + 1
+
+	implicitDimensions := []ArrayDimension{{LowerBound: 1, Length: 0}}
+	for rp < len(src) && src[rp] == '{' {
+		implicitDimensions[len(implicitDimensions)-1].Length = 1
+		implicitDimensions = append(implicitDimensions, ArrayDimension{LowerBound: 1})
+		rp++
+	}
+	currentDim := len(implicitDimensions) - 1
+	counterDim := currentDim
+
+	for currentDim >= 0 {
+		rp = skipArrayTextSpace(src, rp)
+		if rp >= len(src) {
+			return nil, io.ErrUnexpectedEOF
+		}
+
+		switch src[rp] {
+		case '{':
+			if currentDim == counterDim {
+				implicitDimensions[currentDim].Length++
+			}
+			currentDim++
+			rp++
+		case s.delim:
+			rp++
+		case '}':
+			currentDim--
+			if currentDim < counterDim {
+				counterDim = currentDim
+			}
+			rp++
+		default:
+			var isNull bool
+			var err error
+			rp, isNull, err = skipArrayTextElement(src, rp, s.delim)
+			if err != nil {
+				return nil, err
+			}
+			if isNull {
+				s.header.ContainsNull = true
+			}
+			if currentDim == counterDim {
+				implicitDimensions[currentDim].Length++
+			}
+			s.elementCount++
+		}
+	}
+
+	if rp = skipArrayTextSpace(src, rp); rp != len(src) {
+		return nil, fmt.Errorf("unexpected trailing data: %s", src[rp:])
+	}
+
+	return implicitDimensions, nil
+}
+
+// Header returns the array header. Its Dimensions must not be modified.
+func (s *ArrayTextScanner) Header() ArrayHeader {
+	return s.header
+}
+
+// ElementCount returns the total number of elements in the array.
+func (s *ArrayTextScanner) ElementCount() int {
+	return s.elementCount
+}
+
+// ScanDecoder calls Next and decodes the result with d.
+func (s *ArrayTextScanner) ScanDecoder(d TextDecoder) {
+	if s.err != nil {
+		return
+	}
+
+	if s.Next() {
+		s.err = d.DecodeText(s.ci, s.elementBytes)
+	} else if s.err == nil {
+		s.err = errors.New("read past end of array")
+	}
+}
+
+// ScanValue calls Next and scans the result into d.
+func (s *ArrayTextScanner) ScanValue(d interface{}) {
+	if s.err != nil {
+		return
+	}
+
+	if s.Next() {
+		s.err = s.ci.Scan(uint32(s.header.ElementOID), TextFormatCode, s.elementBytes, d)
+	} else if s.err == nil {
+		s.err = errors.New("read past end of array")
+	}
+}
+
+// Next advances the scanner to the next element. It returns false after the last element is read or an error occurs.
+// After Next returns false, the Err method can be called to check if any errors occurred.
+func (s *ArrayTextScanner) Next() bool {
+	if s.err != nil || s.elementIdx == s.elementCount {
+		return false
+	}
+
+	src := s.src
+	rp := s.rp
+	for rp < len(src) && (src[rp] == '{' || src[rp] == '}' || src[rp] == s.delim || isSpace(src[rp])) {
+		rp++
+	}
+
+	end, isNull, err := skipArrayTextElement(src, rp, s.delim)
+	if err != nil {
+		s.err = err
+		return false
+	}
+	s.rp = end
+
+	switch {
+	case isNull:
+		s.elementBytes = nil
+	case src[rp] != '"':
+		s.elementBytes = trimArrayTextSpace(src[rp:end])
+	case bytes.IndexByte(src[rp+1:end-1], '\\') == -1:
+		s.elementBytes = src[rp+1 : end-1]
+	default:
+		s.buf = s.buf[:0]
+		for i := rp + 1; i < end-1; i++ {
+			if src[i] == '\\' {
+				i++
+			}
+			s.buf = append(s.buf, src[i])
+		}
+		s.elementBytes = s.buf
+	}
+
+	s.elementIdx++
+	return true
+}
+
+// Bytes returns the bytes of the element most recently read by Next. It is nil for a NULL element.
+func (s *ArrayTextScanner) Bytes() []byte {
+	return s.elementBytes
+}
+
+// Err returns any error encountered by the scanner.
+func (s *ArrayTextScanner) Err() error {
+	return s.err
+}
+
+func skipArrayTextSpace(src []byte, rp int) int {
+	for rp < len(src) && isSpace(src[rp]) {
+		rp++
+	}
+	return rp
+}
+
+func trimArrayTextSpace(src []byte) []byte {
+	for len(src) > 0 && isSpace(src[0]) {
+		src = src[1:]
+	}
+	for len(src) > 0 && isSpace(src[len(src)-1]) {
+		src = src[:len(src)-1]
+	}
+	return src
+}
+
+// skipArrayTextElement returns the position after the quoted or unquoted element starting at rp and whether it is an
+// unquoted NULL. An unquoted element ends at delim or '}'.
+func skipArrayTextElement(src []byte, rp int, delim byte) (int, bool, error) {
+	if rp >= len(src) {
+		return 0, false, io.ErrUnexpectedEOF
+	}
+
+	if src[rp] == '"' {
+		for rp++; rp < len(src); rp++ {
+			switch src[rp] {
+			case '\\':
+				rp++
+			case '"':
+				return rp + 1, false, nil
+			}
+		}
+		return 0, false, io.ErrUnexpectedEOF
+	}
+
+	start := rp
+	for rp < len(src) && src[rp] != delim && src[rp] != '}' {
+		rp++
+	}
+	if rp == len(src) {
+		return 0, false, io.ErrUnexpectedEOF
+	}
+
+	value := trimArrayTextSpace(src[start:rp])
+	return rp, len(value) == 4 && strings.EqualFold(string(value), "null"), nil
+}
+
+func parseArrayTextInteger(src []byte, rp int) (int32, int, error) {
+	start := rp
+	for rp < len(src) && (('0' <= src[rp] && src[rp] <= '9') || src[rp] == '-') {
+		rp++
+	}
+	n, err := strconv.ParseInt(string(src[start:rp]), 10, 32)
+	if err != nil {
+		return 0, rp, err
+	}
+	return int32(n), rp, nil
+}
+
+// arrayElementCount returns the number of elements in an array with dimensions.
+func arrayElementCount(dimensions []ArrayDimension) int {
+	if len(dimensions) == 0 {
+		return 0
+	}
+
+	count := 1
+	for _, d := range dimensions {
+		count *= int(d.Length)
+	}
+	return count
+}
+
+// ArrayBinaryBuilder incrementally builds a binary encoded array. Elements are appended in row-major order and Finish
+// returns an error unless exactly the number of elements given by the dimensions were appended.
+type ArrayBinaryBuilder struct {
+	ci              *ConnInfo
+	buf             []byte
+	containsNullIdx int
+	elementOID      uint32
+	elementValue    Value
+	elementCount    int
+	expectedCount   int
+	containsNull    bool
+	err             error
+}
+
+// NewArrayBinaryBuilder returns a builder that appends an array of elementOID with dimensions to buf.
+func NewArrayBinaryBuilder(ci *ConnInfo, buf []byte, elementOID uint32, dimensions []ArrayDimension) *ArrayBinaryBuilder {
+	expectedCount := arrayElementCount(dimensions)
+	if expectedCount == 0 {
+		dimensions = nil
+	}
+
+	containsNullIdx := len(buf) + 4
+	buf = ArrayHeader{ElementOID: int32(elementOID), Dimensions: dimensions}.EncodeBinary(ci, buf)
+
+	return &ArrayBinaryBuilder{
+		ci:              ci,
+		buf:             buf,
+		containsNullIdx: containsNullIdx,
+		elementOID:      elementOID,
+		expectedCount:   expectedCount,
+	}
+}
+
+// AppendValue appends field by setting it to the data type registered for the element OID. A nil field is NULL.
+func (b *ArrayBinaryBuilder) AppendValue(field interface{}) {
+	if b.err != nil {
+		return
+	}
+
+	if field == nil {
+		b.AppendRaw(nil)
+		return
+	}
+
+	if b.elementValue == nil {
+		dt, ok := b.ci.DataTypeForOID(b.elementOID)
+		if !ok {
+			b.err = fmt.Errorf("unknown data type for OID: %d", b.elementOID)
+			return
+		}
+		b.elementValue = NewValue(dt.Value)
+	}
+
+	err := b.elementValue.Set(field)
+	if err != nil {
+		b.err = err
+		return
+	}
+
+	binaryEncoder, ok := b.elementValue.(BinaryEncoder)
+	if !ok {
+		b.err = fmt.Errorf("unable to encode binary for OID: %d", b.elementOID)
+		return
+	}
+
+	b.AppendEncoder(binaryEncoder)
+}
+
+// AppendEncoder appends the binary encoding of field. An encoder that encodes to nil appends NULL.
+func (b *ArrayBinaryBuilder) AppendEncoder(field BinaryEncoder) {
+	if b.err != nil {
+		return
+	}
+
+	if b.elementCount == b.expectedCount {
+		b.err = fmt.Errorf("too many elements for array of %d elements", b.expectedCount)
+		return
+	}
+
+	lengthPos := len(b.buf)
+	b.buf = pgio.AppendInt32(b.buf, -1)
+	elemBuf, err := field.EncodeBinary(b.ci, b.buf)
+	if err != nil {
+		b.err = err
+		return
+	}
+	if elemBuf != nil {
+		binary.BigEndian.PutUint32(elemBuf[lengthPos:], uint32(len(elemBuf)-len(b.buf)))
+		b.buf = elemBuf
+	} else {
+		b.containsNull = true
+	}
+
+	b.elementCount++
+}
+
+// AppendRaw appends an element that is already binary encoded. A nil src is NULL.
+func (b *ArrayBinaryBuilder) AppendRaw(src []byte) {
+	if b.err != nil {
+		return
+	}
+
+	if b.elementCount == b.expectedCount {
+		b.err = fmt.Errorf("too many elements for array of %d elements", b.expectedCount)
+		return
+	}
+
+	if src == nil {
+		b.buf = pgio.AppendInt32(b.buf, -1)
+		b.containsNull = true
+	} else {
+		b.buf = pgio.AppendInt32(b.buf, int32(len(src)))
+		b.buf = append(b.buf, src...)
+	}
+
+	b.elementCount++
+}
+
+// Finish returns the encoded array or the first error that occurred.
+func (b *ArrayBinaryBuilder) Finish() ([]byte, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	if b.elementCount != b.expectedCount {
+		return nil, fmt.Errorf("array has %d elements but dimensions require %d", b.elementCount, b.expectedCount)
+	}
+
+	if b.containsNull {
+		binary.BigEndian.PutUint32(b.buf[b.containsNullIdx:], 1)
+	}
+	return b.buf, nil
+}
+
+// ArrayTextBuilder incrementally builds a text encoded array. Elements are appended in row-major order and Finish
+// returns an error unless exactly the number of elements given by the dimensions were appended.
+type ArrayTextBuilder struct {
+	ci            *ConnInfo
+	buf           []byte
+	elementOID    uint32
+	elementValue  Value
+	dimElemCounts []int
+	elementCount  int
+	expectedCount int
+	delim         byte
+	err           error
+	elemBuf       [32]byte
+}
+
+// NewArrayTextBuilder returns a builder that appends an array with dimensions to buf. elementOID is used to find the
+// data type for AppendValue. If it is 0 the data type is found from each value.
+func NewArrayTextBuilder(ci *ConnInfo, buf []byte, elementOID uint32, dimensions []ArrayDimension) *ArrayTextBuilder {
+	return NewArrayTextBuilderWithDelimiter(ci, buf, elementOID, ',', dimensions)
+}
+
+// NewArrayTextBuilderWithDelimiter returns a builder like NewArrayTextBuilder for an array whose elements are
+// separated by delimiter instead of ','. Elements containing delimiter are quoted.
+func NewArrayTextBuilderWithDelimiter(ci *ConnInfo, buf []byte, elementOID uint32, delimiter byte, dimensions []ArrayDimension) *ArrayTextBuilder {
+	expectedCount := arrayElementCount(dimensions)
+	if expectedCount == 0 {
+		return &ArrayTextBuilder{ci: ci, buf: append(buf, '{', '}'), elementOID: elementOID, delim: delimiter}
+	}
+
+	buf = EncodeTextArrayDimensions(buf, dimensions)
+
+	// dimElemCounts is the multiples of elements that each array lies on. See Int4Array.EncodeText.
+	dimElemCounts := make([]int, len(dimensions))
+	dimElemCounts[len(dimensions)-1] = int(dimensions[len(dimensions)-1].Length)
+	for i := len(dimensions) - 2; i > -1; i-- {
+		dimElemCounts[i] = int(dimensions[i].Length) * dimElemCounts[i+1]
+	}
+
+	return &ArrayTextBuilder{
+		ci:            ci,
+		buf:           buf,
+		elementOID:    elementOID,
+		dimElemCounts: dimElemCounts,
+		expectedCount: expectedCount,
+		delim:         delimiter,
+	}
+}
+
+// AppendValue appends field by setting it to the data type for the element OID or, if that is 0, the data type found
+// by DataTypeForValue. A nil field is NULL.
+func (b *ArrayTextBuilder) AppendValue(field interface{}) {
+	if b.err != nil {
+		return
+	}
+
+	if field == nil {
+		b.appendElement(nil)
+		return
+	}
+
+	value := b.elementValue
+	if value == nil {
+		var dt *DataType
+		var ok bool
+		if b.elementOID != 0 {
+			dt, ok = b.ci.DataTypeForOID(b.elementOID)
+		} else {
+			dt, ok = b.ci.DataTypeForValue(field)
+		}
+		if !ok {
+			b.err = fmt.Errorf("unknown data type for field: %v", field)
+			return
+		}
+		value = NewValue(dt.Value)
+		if b.elementOID != 0 {
+			b.elementValue = value
+		}
+	}
+
+	err := value.Set(field)
+	if err != nil {
+		b.err = err
+		return
+	}
+
+	textEncoder, ok := value.(TextEncoder)
+	if !ok {
+		b.err = fmt.Errorf("unable to encode text for value: %v", field)
+		return
+	}
+
+	b.AppendEncoder(textEncoder)
+}
+
+// AppendEncoder appends the text encoding of field quoted if needed. An encoder that encodes to nil appends NULL.
+func (b *ArrayTextBuilder) AppendEncoder(field TextEncoder) {
+	if b.err != nil {
+		return
+	}
+
+	elemBuf, err := field.EncodeText(b.ci, b.elemBuf[0:0])
+	if err != nil {
+		b.err = err
+		return
+	}
+
+	b.appendElement(elemBuf)
+}
+
+// AppendRaw appends an element that is already text encoded. It is quoted if needed. A nil src is NULL.
+func (b *ArrayTextBuilder) AppendRaw(src []byte) {
+	b.appendElement(src)
+}
+
+func (b *ArrayTextBuilder) appendElement(src []byte) {
+	if b.err != nil {
+		return
+	}
+
+	if b.elementCount == b.expectedCount {
+		b.err = fmt.Errorf("too many elements for array of %d elements", b.expectedCount)
+		return
+	}
+
+	i := b.elementCount
+	if i > 0 {
+		b.buf = append(b.buf, b.delim)
+	}
+
+	for _, dec := range b.dimElemCounts {
+		if i%dec == 0 {
+			b.buf = append(b.buf, '{')
+		}
+	}
+
+	if src == nil {
+		b.buf = append(b.buf, `NULL`...)
+	} else {
+		b.buf = append(b.buf, quoteArrayElementIfNeeded(string(src), b.delim)...)
+	}
+
+	for _, dec := range b.dimElemCounts {
+		if (i+1)%dec == 0 {
+			b.buf = append(b.buf, '}')
+		}
+	}
+
+	b.elementCount++
+}
+
+// Finish returns the encoded array or the first error that occurred.
+func (b *ArrayTextBuilder) Finish() ([]byte, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	if b.elementCount != b.expectedCount {
+		return nil, fmt.Errorf("array has %d elements but dimensions require %d", b.elementCount, b.expectedCount)
+	}
+
+	return b.buf, nil
+}
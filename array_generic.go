@@ -0,0 +1,718 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/jackc/pgio"
+)
+
+// Array represents a PostgreSQL array of any Go type T. Elements are converted with the data type registered in
+// ConnInfo for T. If T itself is not registered the data type for its underlying type is used. e.g. Array[MyID] where
+// MyID is defined as type MyID int64 uses the int8 data type. Dimensions and lower bounds are preserved.
+//
+// When Elements is not empty but Dimensions is, the array is treated as a single dimension array.
+type Array[T any] struct {
+	Elements   []T
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+// FlatArray is a single dimension array of T. A nil FlatArray is NULL. Multi-dimensional arrays are flattened when
+// decoded.
+type FlatArray[T any] []T
+
+var (
+	defaultConnInfoOnce sync.Once
+	defaultConnInfo     *ConnInfo
+)
+
+// connInfoOrDefault returns ci or a shared default ConnInfo if ci is nil. This allows generic types to find the data
+// type for their elements when used through database/sql where no ConnInfo is available.
+func connInfoOrDefault(ci *ConnInfo) *ConnInfo {
+	if ci != nil {
+		return ci
+	}
+
+	defaultConnInfoOnce.Do(func() {
+		defaultConnInfo = NewConnInfo()
+		defaultConnInfo.buildReflectTypeToDataType()
+	})
+
+	return defaultConnInfo
+}
+
+var (
+	valueReflectType     = reflect.TypeOf((*Value)(nil)).Elem()
+	typeValueReflectType = reflect.TypeOf((*TypeValue)(nil)).Elem()
+)
+
+// dataTypeForGoType finds the data type registered in ci that can handle values of type t.
+func dataTypeForGoType(ci *ConnInfo, t reflect.Type) (*DataType, bool) {
+	if t == nil || t.Kind() == reflect.Interface || t.Implements(typeValueReflectType) {
+		return nil, false
+	}
+
+	if dt, ok := ci.DataTypeForValue(reflect.Zero(t).Interface()); ok {
+		return dt, true
+	}
+
+	if t.Kind() == reflect.Ptr {
+		return dataTypeForGoType(ci, t.Elem())
+	}
+
+	// pgtype values are registered as pointers.
+	if ptrType := reflect.PtrTo(t); ptrType.Implements(valueReflectType) && !ptrType.Implements(typeValueReflectType) {
+		if dt, ok := ci.DataTypeForValue(reflect.Zero(ptrType).Interface()); ok {
+			return dt, true
+		}
+	}
+
+	if baseType, ok := kindTypes[t.Kind()]; ok && baseType != t {
+		return dataTypeForGoType(ci, baseType)
+	}
+
+	if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 && t != reflect.TypeOf([]byte(nil)) {
+		return dataTypeForGoType(ci, reflect.TypeOf([]byte(nil)))
+	}
+
+	return nil, false
+}
+
+func (src Array[T]) elementType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func (src Array[T]) elementDataType(ci *ConnInfo) (*DataType, error) {
+	dt, ok := dataTypeForGoType(ci, src.elementType())
+	if !ok {
+		return nil, fmt.Errorf("unable to find data type for array element type %v", src.elementType())
+	}
+	return dt, nil
+}
+
+// dimensions returns the dimensions to use when encoding src.
+// checkDimensions returns an error if the number of elements of src does not match dimensions.
+func (src Array[T]) checkDimensions(dimensions []ArrayDimension) error {
+	if count := arrayElementCount(dimensions); count != len(src.Elements) {
+		return fmt.Errorf("array has %d elements but dimensions require %d", len(src.Elements), count)
+	}
+	return nil
+}
+
+func (src Array[T]) dimensions() []ArrayDimension {
+	if len(src.Dimensions) == 0 && len(src.Elements) > 0 {
+		return []ArrayDimension{{Length: int32(len(src.Elements)), LowerBound: 1}}
+	}
+	return src.Dimensions
+}
+
+func (dst *Array[T]) Set(src interface{}) error {
+	// untyped nil and typed nil interfaces are different
+	if src == nil {
+		*dst = Array[T]{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case Array[T]:
+		*dst = value
+	case *Array[T]:
+		if value == nil {
+			*dst = Array[T]{Status: Null}
+		} else {
+			*dst = *value
+		}
+	case []T:
+		dst.setSlice(value)
+	case FlatArray[T]:
+		dst.setSlice(value)
+	default:
+		reflectedValue := reflect.ValueOf(src)
+		if reflectedValue.Kind() == reflect.Ptr {
+			if reflectedValue.IsNil() {
+				*dst = Array[T]{Status: Null}
+				return nil
+			}
+			reflectedValue = reflectedValue.Elem()
+		}
+
+		switch reflectedValue.Kind() {
+		case reflect.Slice:
+			if reflectedValue.IsNil() {
+				*dst = Array[T]{Status: Null}
+				return nil
+			}
+		case reflect.Array:
+		default:
+			return fmt.Errorf("cannot convert %v to %T", src, dst)
+		}
+
+		elemType := dst.elementType()
+		var dimensions []ArrayDimension
+		for v := reflectedValue; v.Type() != elemType; v = v.Index(0) {
+			if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+				return fmt.Errorf("cannot convert %v to %T", src, dst)
+			}
+			dimensions = append(dimensions, ArrayDimension{Length: int32(v.Len()), LowerBound: 1})
+			if v.Len() == 0 {
+				*dst = Array[T]{Status: Present}
+				return nil
+			}
+		}
+
+		elementCount := 1
+		for _, d := range dimensions {
+			elementCount *= int(d.Length)
+		}
+
+		elements := make([]T, 0, elementCount)
+		elements, err := appendArrayElementsRecursive(elements, reflectedValue, dimensions)
+		if err != nil {
+			return err
+		}
+
+		*dst = Array[T]{Elements: elements, Dimensions: dimensions, Status: Present}
+	}
+
+	return nil
+}
+
+func (dst *Array[T]) setSlice(value []T) {
+	if value == nil {
+		*dst = Array[T]{Status: Null}
+	} else if len(value) == 0 {
+		*dst = Array[T]{Status: Present}
+	} else {
+		*dst = Array[T]{
+			Elements:   value,
+			Dimensions: []ArrayDimension{{Length: int32(len(value)), LowerBound: 1}},
+			Status:     Present,
+		}
+	}
+}
+
+func appendArrayElementsRecursive[T any](elements []T, value reflect.Value, dimensions []ArrayDimension) ([]T, error) {
+	if len(dimensions) == 0 {
+		elements = append(elements, value.Interface().(T))
+		return elements, nil
+	}
+
+	if int32(value.Len()) != dimensions[0].Length {
+		return nil, fmt.Errorf("multidimensional arrays must have array expressions with matching dimensions")
+	}
+
+	for i := 0; i < value.Len(); i++ {
+		var err error
+		elements, err = appendArrayElementsRecursive(elements, value.Index(i), dimensions[1:])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return elements, nil
+}
+
+func (dst Array[T]) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *Array[T]) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *Array[T]:
+			*v = *src
+			return nil
+		case *FlatArray[T]:
+			if src.Elements == nil {
+				*v = FlatArray[T]{}
+			} else {
+				*v = src.Elements
+			}
+			return nil
+		case *[]T:
+			if len(src.Dimensions) > 1 {
+				return fmt.Errorf("cannot assign %d dimensional array to %T", len(src.Dimensions), dst)
+			}
+			if src.Elements == nil {
+				*v = []T{}
+			} else {
+				*v = src.Elements
+			}
+			return nil
+		}
+
+		value := reflect.ValueOf(dst)
+		if value.Kind() != reflect.Ptr || value.IsNil() {
+			return fmt.Errorf("cannot assign %T to %T", src, dst)
+		}
+		value = value.Elem()
+
+		switch value.Kind() {
+		case reflect.Array, reflect.Slice:
+		default:
+			return fmt.Errorf("cannot assign %T to %T", src, dst)
+		}
+
+		dimensions := src.dimensions()
+		if len(dimensions) == 0 {
+			if value.Kind() == reflect.Slice {
+				value.Set(reflect.MakeSlice(value.Type(), 0, 0))
+				return nil
+			}
+			if value.Len() == 0 {
+				return nil
+			}
+			return fmt.Errorf("cannot assign empty array to %T", dst)
+		}
+
+		elementCount, err := src.assignToRecursive(value, 0, dimensions)
+		if err != nil {
+			return err
+		}
+		if elementCount != len(src.Elements) {
+			return fmt.Errorf("cannot assign %v, needed to assign %d elements, but only assigned %d", dst, len(src.Elements), elementCount)
+		}
+
+		return nil
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+func (src *Array[T]) assignToRecursive(value reflect.Value, index int, dimensions []ArrayDimension) (int, error) {
+	if len(dimensions) == 0 {
+		elem, ok := value.Addr().Interface().(*T)
+		if !ok {
+			return 0, fmt.Errorf("cannot assign %v element to %v", src.elementType(), value.Type())
+		}
+		*elem = src.Elements[index]
+		return index + 1, nil
+	}
+
+	length := int(dimensions[0].Length)
+	switch value.Kind() {
+	case reflect.Slice:
+		value.Set(reflect.MakeSlice(value.Type(), length, length))
+	case reflect.Array:
+		if value.Len() != length {
+			return 0, fmt.Errorf("expected size %d array, but %s has size %d array", length, value.Type(), value.Len())
+		}
+	default:
+		return 0, fmt.Errorf("incorrect dimensions, expected %d dimensions for %v", len(src.Dimensions), value.Type())
+	}
+
+	for i := 0; i < length; i++ {
+		var err error
+		index, err = src.assignToRecursive(value.Index(i), index, dimensions[1:])
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return index, nil
+}
+
+// assignDecodedValue assigns a value decoded by a registered data type to dst.
+func assignDecodedValue[T any](elem Value, dst *T) error {
+	if p, ok := interface{}(elem).(*T); ok {
+		*dst = *p
+		return nil
+	}
+
+	return elem.AssignTo(dst)
+}
+
+func (dst *Array[T]) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Array[T]{Status: Null}
+		return nil
+	}
+
+	ci = connInfoOrDefault(ci)
+
+	uta, err := ParseUntypedTextArray(string(src))
+	if err != nil {
+		return err
+	}
+
+	var elements []T
+
+	if len(uta.Elements) > 0 {
+		dt, err := dst.elementDataType(ci)
+		if err != nil {
+			return err
+		}
+
+		elem := NewValue(dt.Value)
+		decoder, ok := elem.(TextDecoder)
+		if !ok {
+			return fmt.Errorf("%s does not support the text format", dt.Name)
+		}
+
+		elements = make([]T, len(uta.Elements))
+
+		for i, s := range uta.Elements {
+			var elemSrc []byte
+			if s != "NULL" || uta.Quoted[i] {
+				elemSrc = []byte(s)
+			}
+			err = decoder.DecodeText(ci, elemSrc)
+			if err != nil {
+				return err
+			}
+
+			err = assignDecodedValue(elem, &elements[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	*dst = Array[T]{Elements: elements, Dimensions: uta.Dimensions, Status: Present}
+
+	return nil
+}
+
+func (dst *Array[T]) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Array[T]{Status: Null}
+		return nil
+	}
+
+	ci = connInfoOrDefault(ci)
+
+	var arrayHeader ArrayHeader
+	rp, err := arrayHeader.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if len(arrayHeader.Dimensions) == 0 {
+		*dst = Array[T]{Dimensions: arrayHeader.Dimensions, Status: Present}
+		return nil
+	}
+
+	dt, ok := ci.DataTypeForOID(uint32(arrayHeader.ElementOID))
+	if !ok {
+		dt, err = dst.elementDataType(ci)
+		if err != nil {
+			return err
+		}
+	}
+
+	elem := NewValue(dt.Value)
+	decoder, ok := elem.(BinaryDecoder)
+	if !ok {
+		return fmt.Errorf("%s does not support the binary format", dt.Name)
+	}
+
+	elementCount := arrayHeader.Dimensions[0].Length
+	for _, d := range arrayHeader.Dimensions[1:] {
+		elementCount *= d.Length
+	}
+
+	elements := make([]T, elementCount)
+
+	for i := range elements {
+		if len(src[rp:]) < 4 {
+			return fmt.Errorf("array too short for %d elements", elementCount)
+		}
+		elemLen := int(int32(binary.BigEndian.Uint32(src[rp:])))
+		rp += 4
+		var elemSrc []byte
+		if elemLen >= 0 {
+			if len(src[rp:]) < elemLen {
+				return fmt.Errorf("array element %d too short: %d", i, len(src[rp:]))
+			}
+			elemSrc = src[rp : rp+elemLen]
+			rp += elemLen
+		}
+		err = decoder.DecodeBinary(ci, elemSrc)
+		if err != nil {
+			return err
+		}
+
+		err = assignDecodedValue(elem, &elements[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	*dst = Array[T]{Elements: elements, Dimensions: arrayHeader.Dimensions, Status: Present}
+	return nil
+}
+
+func (src Array[T]) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	dimensions := src.dimensions()
+	if err := src.checkDimensions(dimensions); err != nil {
+		return nil, err
+	}
+	if len(src.Elements) == 0 {
+		return append(buf, '{', '}'), nil
+	}
+
+	ci = connInfoOrDefault(ci)
+
+	dt, err := src.elementDataType(ci)
+	if err != nil {
+		return nil, err
+	}
+
+	elem := NewValue(dt.Value)
+	encoder, ok := elem.(TextEncoder)
+	if !ok {
+		return nil, fmt.Errorf("%s does not support the text format", dt.Name)
+	}
+
+	buf = EncodeTextArrayDimensions(buf, dimensions)
+
+	// dimElemCounts is the multiples of elements that each array lies on. For
+	// example, a single dimension array of length 4 would have a dimElemCounts of
+	// [4]. A multi-dimensional array of lengths [3,5,2] would have a
+	// dimElemCounts of [30,10,2]. This is used to simplify when to render a '{'
+	// or '}'.
+	dimElemCounts := make([]int, len(dimensions))
+	dimElemCounts[len(dimensions)-1] = int(dimensions[len(dimensions)-1].Length)
+	for i := len(dimensions) - 2; i > -1; i-- {
+		dimElemCounts[i] = int(dimensions[i].Length) * dimElemCounts[i+1]
+	}
+
+	inElemBuf := make([]byte, 0, 32)
+	for i := range src.Elements {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+
+		for _, dec := range dimElemCounts {
+			if i%dec == 0 {
+				buf = append(buf, '{')
+			}
+		}
+
+		err := elem.Set(src.Elements[i])
+		if err != nil {
+			return nil, err
+		}
+
+		elemBuf, err := encoder.EncodeText(ci, inElemBuf)
+		if err != nil {
+			return nil, err
+		}
+		if elemBuf == nil {
+			buf = append(buf, `NULL`...)
+		} else {
+			buf = append(buf, QuoteArrayElementIfNeeded(string(elemBuf))...)
+		}
+
+		for _, dec := range dimElemCounts {
+			if (i+1)%dec == 0 {
+				buf = append(buf, '}')
+			}
+		}
+	}
+
+	return buf, nil
+}
+
+func (src Array[T]) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	ci = connInfoOrDefault(ci)
+
+	dt, err := src.elementDataType(ci)
+	if err != nil {
+		return nil, err
+	}
+
+	elem := NewValue(dt.Value)
+	encoder, ok := elem.(BinaryEncoder)
+	if !ok {
+		return nil, fmt.Errorf("%s does not support the binary format", dt.Name)
+	}
+
+	dimensions := src.dimensions()
+	if err := src.checkDimensions(dimensions); err != nil {
+		return nil, err
+	}
+	if len(src.Elements) == 0 {
+		dimensions = nil
+	}
+
+	arrayHeader := ArrayHeader{
+		Dimensions: dimensions,
+		ElementOID: int32(dt.OID),
+	}
+
+	// ContainsNull is not known until the elements are encoded. It is the second int32 of the header.
+	containsNullPos := len(buf) + 4
+	buf = arrayHeader.EncodeBinary(ci, buf)
+
+	for i := range src.Elements {
+		err := elem.Set(src.Elements[i])
+		if err != nil {
+			return nil, err
+		}
+
+		sp := len(buf)
+		buf = pgio.AppendInt32(buf, -1)
+
+		elemBuf, err := encoder.EncodeBinary(ci, buf)
+		if err != nil {
+			return nil, err
+		}
+		if elemBuf != nil {
+			buf = elemBuf
+			pgio.SetInt32(buf[sp:], int32(len(buf[sp:])-4))
+		} else {
+			pgio.SetInt32(buf[containsNullPos:], 1)
+		}
+	}
+
+	return buf, nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Array[T]) Scan(src interface{}) error {
+	if src == nil {
+		return dst.DecodeText(nil, nil)
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Array[T]) Value() (driver.Value, error) {
+	buf, err := src.EncodeText(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+
+	return string(buf), nil
+}
+
+func (src FlatArray[T]) toArray() Array[T] {
+	var a Array[T]
+	a.setSlice(src)
+	return a
+}
+
+func (dst *FlatArray[T]) fromArray(a Array[T]) {
+	switch a.Status {
+	case Present:
+		if a.Elements == nil {
+			*dst = FlatArray[T]{}
+		} else {
+			*dst = a.Elements
+		}
+	default:
+		*dst = nil
+	}
+}
+
+func (dst *FlatArray[T]) Set(src interface{}) error {
+	var a Array[T]
+	err := a.Set(src)
+	if err != nil {
+		return err
+	}
+
+	dst.fromArray(a)
+	return nil
+}
+
+func (dst FlatArray[T]) Get() interface{} {
+	if dst == nil {
+		return nil
+	}
+	return []T(dst)
+}
+
+func (src *FlatArray[T]) AssignTo(dst interface{}) error {
+	a := src.toArray()
+	return a.AssignTo(dst)
+}
+
+func (dst *FlatArray[T]) DecodeText(ci *ConnInfo, src []byte) error {
+	var a Array[T]
+	err := a.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+
+	dst.fromArray(a)
+	return nil
+}
+
+func (dst *FlatArray[T]) DecodeBinary(ci *ConnInfo, src []byte) error {
+	var a Array[T]
+	err := a.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	dst.fromArray(a)
+	return nil
+}
+
+func (src FlatArray[T]) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return src.toArray().EncodeText(ci, buf)
+}
+
+func (src FlatArray[T]) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return src.toArray().EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *FlatArray[T]) Scan(src interface{}) error {
+	var a Array[T]
+	err := a.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	dst.fromArray(a)
+	return nil
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src FlatArray[T]) Value() (driver.Value, error) {
+	return src.toArray().Value()
+}
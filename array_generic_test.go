@@ -0,0 +1,215 @@
+package pgtype_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type arrayTestID int64
+
+func TestArrayGenericTranscode(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	src := pgtype.Array[arrayTestID]{
+		Elements:   []arrayTestID{1, 2, 3, 4},
+		Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 4}, {Length: 2, LowerBound: 2}},
+		Status:     pgtype.Present,
+	}
+
+	var dst pgtype.Array[arrayTestID]
+	err := conn.QueryRow(context.Background(), "select $1::int8[]", src).Scan(&dst)
+	require.NoError(t, err)
+	require.Equal(t, src, dst)
+
+	var ids []arrayTestID
+	err = conn.QueryRow(context.Background(), "select '{5,6,7}'::int8[]").Scan((*pgtype.FlatArray[arrayTestID])(&ids))
+	require.NoError(t, err)
+	require.Equal(t, []arrayTestID{5, 6, 7}, ids)
+}
+
+func TestArrayGenericSetAndAssignTo(t *testing.T) {
+	var a pgtype.Array[arrayTestID]
+
+	err := a.Set([]arrayTestID{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Array[arrayTestID]{
+		Elements:   []arrayTestID{1, 2},
+		Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+		Status:     pgtype.Present,
+	}, a)
+
+	err = a.Set([][]arrayTestID{{1, 2, 3}, {4, 5, 6}})
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Array[arrayTestID]{
+		Elements:   []arrayTestID{1, 2, 3, 4, 5, 6},
+		Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}, {Length: 3, LowerBound: 1}},
+		Status:     pgtype.Present,
+	}, a)
+
+	var matrix [][]arrayTestID
+	err = a.AssignTo(&matrix)
+	require.NoError(t, err)
+	require.Equal(t, [][]arrayTestID{{1, 2, 3}, {4, 5, 6}}, matrix)
+
+	var fixed [2][3]arrayTestID
+	err = a.AssignTo(&fixed)
+	require.NoError(t, err)
+	require.Equal(t, [2][3]arrayTestID{{1, 2, 3}, {4, 5, 6}}, fixed)
+
+	var flat pgtype.FlatArray[arrayTestID]
+	err = a.AssignTo(&flat)
+	require.NoError(t, err)
+	require.Equal(t, pgtype.FlatArray[arrayTestID]{1, 2, 3, 4, 5, 6}, flat)
+
+	var slice []arrayTestID
+	err = a.AssignTo(&slice)
+	require.Error(t, err)
+
+	err = a.Set([][]arrayTestID{{1, 2, 3}, {4}})
+	require.Error(t, err)
+
+	err = a.Set([]arrayTestID(nil))
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Array[arrayTestID]{Status: pgtype.Null}, a)
+
+	slice = []arrayTestID{1}
+	err = a.AssignTo(&slice)
+	require.NoError(t, err)
+	require.Nil(t, slice)
+}
+
+func TestArrayGenericCodecs(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	tests := []struct {
+		src  pgtype.Array[arrayTestID]
+		text string
+	}{
+		{
+			src:  pgtype.Array[arrayTestID]{Status: pgtype.Present},
+			text: "{}",
+		},
+		{
+			src: pgtype.Array[arrayTestID]{
+				Elements:   []arrayTestID{1, -2},
+				Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+				Status:     pgtype.Present,
+			},
+			text: "{1,-2}",
+		},
+		{
+			src: pgtype.Array[arrayTestID]{
+				Elements:   []arrayTestID{1, 2, 3, 4},
+				Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 4}, {Length: 2, LowerBound: 2}},
+				Status:     pgtype.Present,
+			},
+			text: "[4:5][2:3]={{1,2},{3,4}}",
+		},
+	}
+
+	for i, tt := range tests {
+		buf, err := tt.src.EncodeText(ci, nil)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.text, string(buf), "%d", i)
+
+		var dst pgtype.Array[arrayTestID]
+		err = dst.DecodeText(ci, buf)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.src, dst, "%d", i)
+
+		buf, err = tt.src.EncodeBinary(ci, nil)
+		require.NoErrorf(t, err, "%d", i)
+
+		var int8Array pgtype.Int8Array
+		err = int8Array.DecodeBinary(ci, buf)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.src.Dimensions, int8Array.Dimensions, "%d", i)
+
+		dst = pgtype.Array[arrayTestID]{}
+		err = dst.DecodeBinary(ci, buf)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.src, dst, "%d", i)
+	}
+}
+
+func TestArrayGenericEncodeMismatchedDimensions(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	for i, src := range []pgtype.Array[int32]{
+		{Elements: []int32{1, 2, 3}, Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}}, Status: pgtype.Present},
+		{Elements: []int32{1, 2}, Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}, {Length: 2, LowerBound: 1}}, Status: pgtype.Present},
+		{Dimensions: []pgtype.ArrayDimension{{Length: 1, LowerBound: 1}}, Status: pgtype.Present},
+	} {
+		_, err := src.EncodeText(ci, nil)
+		require.Errorf(t, err, "%d", i)
+
+		_, err = src.EncodeBinary(ci, nil)
+		require.Errorf(t, err, "%d", i)
+	}
+}
+
+func TestArrayGenericNullElements(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	one := "one"
+	src := pgtype.FlatArray[*string]{&one, nil}
+
+	buf, err := src.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+
+	var textArray pgtype.TextArray
+	err = textArray.DecodeBinary(ci, buf)
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Null, textArray.Elements[1].Status)
+
+	var dst pgtype.FlatArray[*string]
+	err = dst.DecodeBinary(ci, buf)
+	require.NoError(t, err)
+	require.Len(t, dst, 2)
+	require.Equal(t, "one", *dst[0])
+	require.Nil(t, dst[1])
+
+	var strings pgtype.FlatArray[string]
+	err = strings.DecodeBinary(ci, buf)
+	require.Error(t, err)
+}
+
+func TestArrayGenericOfPgtypeValues(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	src := pgtype.FlatArray[pgtype.Int4]{{Int: 1, Status: pgtype.Present}, {Status: pgtype.Null}}
+
+	buf, err := src.EncodeText(ci, nil)
+	require.NoError(t, err)
+	require.Equal(t, "{1,NULL}", string(buf))
+
+	var dst pgtype.FlatArray[pgtype.Int4]
+	err = dst.DecodeText(ci, buf)
+	require.NoError(t, err)
+	require.Equal(t, src, dst)
+}
+
+func TestFlatArrayScanAndValue(t *testing.T) {
+	var dst pgtype.FlatArray[arrayTestID]
+
+	err := dst.Scan("{{1,2},{3,4}}")
+	require.NoError(t, err)
+	require.Equal(t, pgtype.FlatArray[arrayTestID]{1, 2, 3, 4}, dst)
+
+	value, err := dst.Value()
+	require.NoError(t, err)
+	require.Equal(t, "{1,2,3,4}", value)
+
+	err = dst.Scan(nil)
+	require.NoError(t, err)
+	require.Nil(t, dst)
+
+	value, err = dst.Value()
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
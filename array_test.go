@@ -133,3 +133,338 @@ func TestArrayAssignToEmptyToNonSlice(t *testing.T) {
 	err = a.AssignTo(&iface)
 	require.EqualError(t, err, "cannot assign *pgtype.Int4Array to *interface {}")
 }
+
+func TestArrayBinaryScanner(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	src := pgtype.Int4Array{
+		Elements: []pgtype.Int4{
+			{Int: 1, Status: pgtype.Present},
+			{Status: pgtype.Null},
+			{Int: 3, Status: pgtype.Present},
+			{Int: 4, Status: pgtype.Present},
+		},
+		Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}, {Length: 2, LowerBound: 0}},
+		Status:     pgtype.Present,
+	}
+	buf, err := src.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+
+	s := pgtype.NewArrayBinaryScanner(ci, buf)
+	require.NoError(t, s.Err())
+	require.Equal(t, pgtype.ArrayHeader{
+		ContainsNull: true,
+		ElementOID:   pgtype.Int4OID,
+		Dimensions:   src.Dimensions,
+	}, s.Header())
+	require.Equal(t, 4, s.ElementCount())
+
+	dst := make([]*int32, s.ElementCount())
+	for i := range dst {
+		s.ScanValue(&dst[i])
+	}
+	require.NoError(t, s.Err())
+	require.Equal(t, int32(1), *dst[0])
+	require.Nil(t, dst[1])
+	require.Equal(t, int32(3), *dst[2])
+	require.Equal(t, int32(4), *dst[3])
+
+	require.False(t, s.Next())
+	var n pgtype.Int4
+	s.ScanDecoder(&n)
+	require.EqualError(t, s.Err(), "read past end of array")
+
+	s = pgtype.NewArrayBinaryScanner(ci, buf)
+	var elements []pgtype.Int4
+	for s.Next() {
+		var e pgtype.Int4
+		require.NoError(t, e.DecodeBinary(ci, s.Bytes()))
+		elements = append(elements, e)
+	}
+	require.NoError(t, s.Err())
+	require.Equal(t, src.Elements, elements)
+
+	s = pgtype.NewArrayBinaryScanner(ci, buf[:len(buf)-2])
+	for s.Next() {
+	}
+	require.Error(t, s.Err())
+
+	s = pgtype.NewArrayBinaryScanner(ci, buf[:4])
+	require.Error(t, s.Err())
+	require.False(t, s.Next())
+
+	empty, err := pgtype.Int4Array{Status: pgtype.Present}.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+	s = pgtype.NewArrayBinaryScanner(ci, empty)
+	require.Equal(t, 0, s.ElementCount())
+	require.False(t, s.Next())
+	require.NoError(t, s.Err())
+}
+
+func TestArrayBinaryScannerDoesNotAllocatePerElement(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	var src pgtype.Int4Array
+	require.NoError(t, src.Set(make([]int32, 1000)))
+	buf, err := src.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+
+	dst := make([]pgtype.Int4, 1000)
+	allocs := testing.AllocsPerRun(10, func() {
+		s := pgtype.NewArrayBinaryScanner(ci, buf)
+		for i := range dst {
+			s.ScanDecoder(&dst[i])
+		}
+	})
+	require.Less(t, allocs, 10.0)
+}
+
+func TestArrayTextScanner(t *testing.T) {
+	tests := []struct {
+		source       string
+		elements     []interface{}
+		dimensions   []pgtype.ArrayDimension
+		containsNull bool
+	}{
+		{source: "{}"},
+		{source: " { } "},
+		{source: "{1}", elements: []interface{}{"1"}, dimensions: []pgtype.ArrayDimension{{Length: 1, LowerBound: 1}}},
+		{source: "{a, b }", elements: []interface{}{"a", "b"}, dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}}},
+		{
+			source:       `{"NULL",NULL,null}`,
+			elements:     []interface{}{"NULL", nil, nil},
+			dimensions:   []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			containsNull: true,
+		},
+		{source: `{""}`, elements: []interface{}{""}, dimensions: []pgtype.ArrayDimension{{Length: 1, LowerBound: 1}}},
+		{
+			source:     `{"He said, \"Hello.\"","a\\b"}`,
+			elements:   []interface{}{`He said, "Hello."`, `a\b`},
+			dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+		},
+		{
+			source:     "{{a,b},{c,d},{e,f}}",
+			elements:   []interface{}{"a", "b", "c", "d", "e", "f"},
+			dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}, {Length: 2, LowerBound: 1}},
+		},
+		{
+			source:     "{{{a,b},{c,d},{e,f}},{{a,b},{c,d},{e,f}}}",
+			elements:   []interface{}{"a", "b", "c", "d", "e", "f", "a", "b", "c", "d", "e", "f"},
+			dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}, {Length: 3, LowerBound: 1}, {Length: 2, LowerBound: 1}},
+		},
+		{
+			source:     "[4:4]={1}",
+			elements:   []interface{}{"1"},
+			dimensions: []pgtype.ArrayDimension{{Length: 1, LowerBound: 4}},
+		},
+		{
+			source:     "[4:5][2:3]={{a,b},{c,d}}",
+			elements:   []interface{}{"a", "b", "c", "d"},
+			dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 4}, {Length: 2, LowerBound: 2}},
+		},
+		{
+			source:     "[-4:-2]={1,2,3}",
+			elements:   []interface{}{"1", "2", "3"},
+			dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: -4}},
+		},
+	}
+
+	for i, tt := range tests {
+		s := pgtype.NewArrayTextScanner(nil, 0, []byte(tt.source))
+		require.NoErrorf(t, s.Err(), "%d", i)
+		require.Equalf(t, pgtype.ArrayHeader{ContainsNull: tt.containsNull, Dimensions: tt.dimensions}, s.Header(), "%d", i)
+		require.Equalf(t, len(tt.elements), s.ElementCount(), "%d", i)
+
+		var elements []interface{}
+		for s.Next() {
+			if s.Bytes() == nil {
+				elements = append(elements, nil)
+			} else {
+				elements = append(elements, string(s.Bytes()))
+			}
+		}
+		require.NoErrorf(t, s.Err(), "%d", i)
+		require.Equalf(t, tt.elements, elements, "%d", i)
+	}
+
+	for i, src := range []string{"", "1,2", "{1,2", `{"a}`, "{1}x", "[1:2]{1,2}", "[a:2]={1,2}"} {
+		s := pgtype.NewArrayTextScanner(nil, 0, []byte(src))
+		require.Errorf(t, s.Err(), "%d", i)
+		require.Falsef(t, s.Next(), "%d", i)
+	}
+}
+
+func TestArrayTextScannerScanValue(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	s := pgtype.NewArrayTextScanner(ci, pgtype.Int8OID, []byte("{1,NULL,3}"))
+	require.Equal(t, int32(pgtype.Int8OID), s.Header().ElementOID)
+
+	dst := make([]pgtype.Int8, s.ElementCount())
+	for i := range dst {
+		s.ScanDecoder(&dst[i])
+	}
+	require.NoError(t, s.Err())
+	require.Equal(t, []pgtype.Int8{{Int: 1, Status: pgtype.Present}, {Status: pgtype.Null}, {Int: 3, Status: pgtype.Present}}, dst)
+
+	s = pgtype.NewArrayTextScanner(ci, pgtype.Int8OID, []byte("{1,2}"))
+	var a, b int64
+	s.ScanValue(&a)
+	s.ScanValue(&b)
+	require.NoError(t, s.Err())
+	require.Equal(t, int64(1), a)
+	require.Equal(t, int64(2), b)
+	s.ScanValue(&a)
+	require.EqualError(t, s.Err(), "read past end of array")
+}
+
+func TestArrayTextScannerBoxArray(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	s := pgtype.NewArrayTextScannerWithDelimiter(ci, pgtype.BoxOID, ';', []byte("{(3,4),(1,2);NULL;\"(5,6),(0,0)\"}"))
+	require.NoError(t, s.Err())
+	require.Equal(t, pgtype.ArrayHeader{
+		ContainsNull: true,
+		ElementOID:   pgtype.BoxOID,
+		Dimensions:   []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+	}, s.Header())
+
+	dst := make([]pgtype.Box, s.ElementCount())
+	for i := range dst {
+		s.ScanValue(&dst[i])
+	}
+	require.NoError(t, s.Err())
+	require.Equal(t, []pgtype.Box{
+		{P: [2]pgtype.Vec2{{X: 3, Y: 4}, {X: 1, Y: 2}}, Status: pgtype.Present},
+		{Status: pgtype.Null},
+		{P: [2]pgtype.Vec2{{X: 5, Y: 6}, {X: 0, Y: 0}}, Status: pgtype.Present},
+	}, dst)
+
+	s = pgtype.NewArrayTextScannerWithDelimiter(ci, pgtype.BoxOID, ';', []byte("{{(1,1),(0,0);(2,2),(0,0)};{(3,3),(0,0);(4,4),(0,0)}}"))
+	require.NoError(t, s.Err())
+	require.Equal(t, []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}, {Length: 2, LowerBound: 1}}, s.Header().Dimensions)
+	require.Equal(t, 4, s.ElementCount())
+}
+
+func TestArrayBinaryBuilder(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	dimensions := []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}, {Length: 2, LowerBound: 0}}
+
+	b := pgtype.NewArrayBinaryBuilder(ci, []byte("prefix"), pgtype.Int4OID, dimensions)
+	b.AppendValue(int32(1))
+	b.AppendValue(nil)
+	b.AppendEncoder(pgtype.Int4{Int: 3, Status: pgtype.Present})
+	b.AppendRaw([]byte{0, 0, 0, 4})
+	buf, err := b.Finish()
+	require.NoError(t, err)
+	require.Equal(t, "prefix", string(buf[:6]))
+
+	var dst pgtype.Int4Array
+	require.NoError(t, dst.DecodeBinary(ci, buf[6:]))
+	require.Equal(t, pgtype.Int4Array{
+		Elements: []pgtype.Int4{
+			{Int: 1, Status: pgtype.Present},
+			{Status: pgtype.Null},
+			{Int: 3, Status: pgtype.Present},
+			{Int: 4, Status: pgtype.Present},
+		},
+		Dimensions: dimensions,
+		Status:     pgtype.Present,
+	}, dst)
+	require.True(t, pgtype.NewArrayBinaryScanner(ci, buf[6:]).Header().ContainsNull)
+
+	// Same encoding as the typed array.
+	expected, err := dst.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+	require.Equal(t, expected, buf[6:])
+
+	b = pgtype.NewArrayBinaryBuilder(ci, nil, pgtype.Int4OID, nil)
+	buf, err = b.Finish()
+	require.NoError(t, err)
+	expected, err = pgtype.Int4Array{Status: pgtype.Present}.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+	require.Equal(t, expected, buf)
+
+	b = pgtype.NewArrayBinaryBuilder(ci, nil, pgtype.Int4OID, []pgtype.ArrayDimension{{Length: 1, LowerBound: 1}})
+	_, err = b.Finish()
+	require.Error(t, err)
+
+	b = pgtype.NewArrayBinaryBuilder(ci, nil, pgtype.Int4OID, []pgtype.ArrayDimension{{Length: 1, LowerBound: 1}})
+	b.AppendValue(int32(1))
+	b.AppendValue(int32(2))
+	_, err = b.Finish()
+	require.Error(t, err)
+
+	b = pgtype.NewArrayBinaryBuilder(ci, nil, 999999, []pgtype.ArrayDimension{{Length: 1, LowerBound: 1}})
+	b.AppendValue(int32(1))
+	_, err = b.Finish()
+	require.Error(t, err)
+}
+
+func TestArrayTextBuilder(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	tests := []struct {
+		elementOID uint32
+		dimensions []pgtype.ArrayDimension
+		elements   []interface{}
+		result     string
+	}{
+		{elementOID: pgtype.TextOID, result: "{}"},
+		{
+			elementOID: pgtype.TextOID,
+			dimensions: []pgtype.ArrayDimension{{Length: 4, LowerBound: 1}},
+			elements:   []interface{}{"a", nil, "NULL", `He said, "Hello."`},
+			result:     `{a,NULL,"NULL","He said, \"Hello.\""}`,
+		},
+		{
+			elementOID: pgtype.Int8OID,
+			dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}, {Length: 3, LowerBound: 1}},
+			elements:   []interface{}{1, 2, 3, 4, 5, 6},
+			result:     "{{1,2,3},{4,5,6}}",
+		},
+		{
+			dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 0}},
+			elements:   []interface{}{int32(1), "x y"},
+			result:     "[0:1]={1,x y}",
+		},
+	}
+
+	for i, tt := range tests {
+		b := pgtype.NewArrayTextBuilder(ci, nil, tt.elementOID, tt.dimensions)
+		for _, e := range tt.elements {
+			b.AppendValue(e)
+		}
+		buf, err := b.Finish()
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, string(buf), "%d", i)
+	}
+
+	b := pgtype.NewArrayTextBuilder(ci, nil, 0, []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}})
+	b.AppendRaw([]byte("a b"))
+	b.AppendRaw(nil)
+	b.AppendEncoder(pgtype.Text{String: "", Status: pgtype.Present})
+	buf, err := b.Finish()
+	require.NoError(t, err)
+	require.Equal(t, `{a b,NULL,""}`, string(buf))
+
+	b = pgtype.NewArrayTextBuilderWithDelimiter(ci, nil, pgtype.BoxOID, ';', []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}})
+	b.AppendEncoder(pgtype.Box{P: [2]pgtype.Vec2{{X: 3, Y: 4}, {X: 1, Y: 2}}, Status: pgtype.Present})
+	b.AppendValue(nil)
+	b.AppendRaw([]byte("a;b"))
+	buf, err = b.Finish()
+	require.NoError(t, err)
+	require.Equal(t, `{(3,4),(1,2);NULL;"a;b"}`, string(buf))
+
+	b = pgtype.NewArrayTextBuilderWithDelimiter(ci, nil, 0, ':', []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}})
+	b.AppendRaw([]byte("a:b"))
+	b.AppendRaw([]byte("c,d"))
+	buf, err = b.Finish()
+	require.NoError(t, err)
+	require.Equal(t, `{"a:b":c,d}`, string(buf))
+
+	b = pgtype.NewArrayTextBuilder(ci, nil, pgtype.TextOID, []pgtype.ArrayDimension{{Length: 1, LowerBound: 1}})
+	_, err = b.Finish()
+	require.Error(t, err)
+	b.AppendValue("a")
+	b.AppendValue("b")
+	_, err = b.Finish()
+	require.Error(t, err)
+}
@@ -20,11 +20,18 @@ type ArrayType struct {
 	newElement func() ValueTranscoder
 
 	elementOID uint32
+	delimiter  byte
 	status     Status
 }
 
 func NewArrayType(typeName string, elementOID uint32, newElement func() ValueTranscoder) *ArrayType {
-	return &ArrayType{typeName: typeName, elementOID: elementOID, newElement: newElement}
+	return NewArrayTypeWithDelimiter(typeName, elementOID, ',', newElement)
+}
+
+// NewArrayTypeWithDelimiter returns an ArrayType whose text format separates elements with delimiter instead of ','.
+// This is the typdelim of the element type in pg_type. e.g. box and the PostGIS geometry types use ';' and ':'.
+func NewArrayTypeWithDelimiter(typeName string, elementOID uint32, delimiter byte, newElement func() ValueTranscoder) *ArrayType {
+	return &ArrayType{typeName: typeName, elementOID: elementOID, delimiter: delimiter, newElement: newElement}
 }
 
 func (at *ArrayType) NewTypeValue() Value {
@@ -35,6 +42,7 @@ func (at *ArrayType) NewTypeValue() Value {
 
 		typeName:   at.typeName,
 		elementOID: at.elementOID,
+		delimiter:  at.delimiter,
 		newElement: at.newElement,
 	}
 }
@@ -141,7 +149,7 @@ func (dst *ArrayType) DecodeText(ci *ConnInfo, src []byte) error {
 		return nil
 	}
 
-	uta, err := ParseUntypedTextArray(string(src))
+	uta, err := parseUntypedTextArray(string(src), dst.delimiter)
 	if err != nil {
 		return err
 	}
@@ -253,7 +261,7 @@ func (src ArrayType) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
 	inElemBuf := make([]byte, 0, 32)
 	for i, elem := range src.elements {
 		if i > 0 {
-			buf = append(buf, ',')
+			buf = append(buf, src.delimiter)
 		}
 
 		for _, dec := range dimElemCounts {
@@ -269,7 +277,7 @@ func (src ArrayType) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
 		if elemBuf == nil {
 			buf = append(buf, `NULL`...)
 		} else {
-			buf = append(buf, QuoteArrayElementIfNeeded(string(elemBuf))...)
+			buf = append(buf, quoteArrayElementIfNeeded(string(elemBuf), src.delimiter)...)
 		}
 
 		for _, dec := range dimElemCounts {
@@ -44,6 +44,26 @@ func TestArrayTypeValue(t *testing.T) {
 	require.EqualValues(t, []string{"baz", "quz"}, slice)
 }
 
+func TestArrayTypeWithDelimiterText(t *testing.T) {
+	arrayType := pgtype.NewArrayTypeWithDelimiter("_text", pgtype.TextOID, ';', func() pgtype.ValueTranscoder { return &pgtype.Text{} })
+
+	err := arrayType.Set([]string{"a;b", "c,d", "e"})
+	require.NoError(t, err)
+
+	buf, err := arrayType.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, `{"a;b";c,d;e}`, string(buf))
+
+	dst := arrayType.NewTypeValue().(*pgtype.ArrayType)
+	err = dst.DecodeText(nil, buf)
+	require.NoError(t, err)
+
+	var slice []string
+	err = dst.AssignTo(&slice)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a;b", "c,d", "e"}, slice)
+}
+
 func TestArrayTypeTranscode(t *testing.T) {
 	conn := testutil.MustConnectPgx(t)
 	defer testutil.MustCloseContext(t, conn)
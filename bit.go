@@ -2,6 +2,7 @@ package pgtype
 
 import (
 	"database/sql/driver"
+	"fmt"
 )
 
 type Bit Varbit
@@ -10,6 +11,18 @@ func (dst *Bit) Set(src interface{}) error {
 	return (*Varbit)(dst).Set(src)
 }
 
+// ApplyTypeModifier returns an error if dst does not have exactly the number of bits of a bit(n) typmod.
+func (dst *Bit) ApplyTypeModifier(typmod int32) error {
+	if dst.Status != Present || typmod < 0 {
+		return nil
+	}
+
+	if dst.Len != typmod {
+		return fmt.Errorf("bit string length %d does not match type bit(%d)", dst.Len, typmod)
+	}
+	return nil
+}
+
 func (dst Bit) Get() interface{} {
 	return (Varbit)(dst).Get()
 }
@@ -43,3 +56,53 @@ func (dst *Bit) Scan(src interface{}) error {
 func (src Bit) Value() (driver.Value, error) {
 	return (Varbit)(src).Value()
 }
+
+// BitAt returns bit i of src where bit 0 is the first, leftmost bit. It panics if i is out of range.
+func (src Bit) BitAt(i int) bool {
+	return (Varbit)(src).BitAt(i)
+}
+
+// SetBitAt sets bit i of dst where bit 0 is the first, leftmost bit. It panics if i is out of range.
+func (dst *Bit) SetBitAt(i int, value bool) {
+	(*Varbit)(dst).SetBitAt(i, value)
+}
+
+// And returns the bitwise AND of src and x. Like PostgreSQL both must have the same length.
+func (src Bit) And(x Bit) (Bit, error) {
+	r, err := (Varbit)(src).And(Varbit(x))
+	return Bit(r), err
+}
+
+// Or returns the bitwise OR of src and x. Like PostgreSQL both must have the same length.
+func (src Bit) Or(x Bit) (Bit, error) {
+	r, err := (Varbit)(src).Or(Varbit(x))
+	return Bit(r), err
+}
+
+// Xor returns the bitwise XOR of src and x. Like PostgreSQL both must have the same length.
+func (src Bit) Xor(x Bit) (Bit, error) {
+	r, err := (Varbit)(src).Xor(Varbit(x))
+	return Bit(r), err
+}
+
+// Not returns the bitwise complement of src.
+func (src Bit) Not() Bit {
+	return Bit((Varbit)(src).Not())
+}
+
+// ShiftLeft returns src shifted n bits to the left keeping its length. Bits shifted in are zero. A negative n shifts to
+// the right.
+func (src Bit) ShiftLeft(n int) Bit {
+	return Bit((Varbit)(src).ShiftLeft(n))
+}
+
+// ShiftRight returns src shifted n bits to the right keeping its length. Bits shifted in are zero. A negative n shifts
+// to the left.
+func (src Bit) ShiftRight(n int) Bit {
+	return Bit((Varbit)(src).ShiftRight(n))
+}
+
+// OnesCount returns the number of set bits in src.
+func (src Bit) OnesCount() int {
+	return (Varbit)(src).OnesCount()
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBitTranscode(t *testing.T) {
@@ -23,3 +24,40 @@ func TestBitNormalize(t *testing.T) {
 		},
 	})
 }
+
+func TestBitApplyTypeModifier(t *testing.T) {
+	v := pgtype.Bit{Bytes: []byte{255}, Len: 8, Status: pgtype.Present}
+	require.NoError(t, v.ApplyTypeModifier(8))
+	require.NoError(t, v.ApplyTypeModifier(-1))
+	require.Error(t, v.ApplyTypeModifier(7))
+	require.Error(t, v.ApplyTypeModifier(9))
+}
+
+func TestBitOperations(t *testing.T) {
+	var a, b pgtype.Bit
+	require.NoError(t, a.Set("1100"))
+	require.NoError(t, b.Set("1010"))
+
+	r, err := a.Xor(b)
+	require.NoError(t, err)
+	var s string
+	require.NoError(t, r.AssignTo(&s))
+	require.Equal(t, "0110", s)
+
+	r = a.Not()
+	require.NoError(t, r.AssignTo(&s))
+	require.Equal(t, "0011", s)
+
+	r = a.ShiftRight(1)
+	require.NoError(t, r.AssignTo(&s))
+	require.Equal(t, "0110", s)
+
+	var n uint64
+	require.NoError(t, a.AssignTo(&n))
+	require.Equal(t, uint64(12), n)
+	require.Equal(t, 2, a.OnesCount())
+	require.True(t, a.BitAt(1))
+
+	_, err = a.And(pgtype.Bit{Bytes: []byte{0}, Len: 8, Status: pgtype.Present})
+	require.Error(t, err)
+}
@@ -3,6 +3,8 @@ package pgtype
 import (
 	"database/sql/driver"
 	"fmt"
+	"strings"
+	"unicode/utf8"
 )
 
 // BPChar is fixed-length, blank padded char type
@@ -19,6 +21,25 @@ func (dst BPChar) Get() interface{} {
 	return (Text)(dst).Get()
 }
 
+// ApplyTypeModifier pads dst with spaces to the length of a bpchar(n) typmod. Like PostgreSQL it is an error if dst is
+// longer unless the excess characters are all spaces, which are discarded.
+func (dst *BPChar) ApplyTypeModifier(typmod int32) error {
+	if dst.Status != Present || typmod < varlenaHeaderSize {
+		return nil
+	}
+
+	n := int(typmod - varlenaHeaderSize)
+	s, err := limitCharacterLength(dst.String, n, "character")
+	if err != nil {
+		return err
+	}
+	if count := utf8.RuneCountInString(s); count < n {
+		s += strings.Repeat(" ", n-count)
+	}
+	dst.String = s
+	return nil
+}
+
 // AssignTo assigns from src to dst.
 func (src *BPChar) AssignTo(dst interface{}) error {
 	switch src.Status {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/require"
 )
 
 func TestChar3Transcode(t *testing.T) {
@@ -49,3 +50,27 @@ func TestBPCharAssignTo(t *testing.T) {
 	}
 
 }
+
+func TestBPCharApplyTypeModifier(t *testing.T) {
+	tests := []struct {
+		src    string
+		typmod int32
+		result string
+	}{
+		{src: "ab", typmod: 5 + 4, result: "ab   "},
+		{src: "嗨", typmod: 3 + 4, result: "嗨  "},
+		{src: "abc  ", typmod: 3 + 4, result: "abc"},
+		{src: "abcde", typmod: 5 + 4, result: "abcde"},
+		{src: "ab", typmod: -1, result: "ab"},
+	}
+
+	for i, tt := range tests {
+		v := pgtype.BPChar{String: tt.src, Status: pgtype.Present}
+		err := v.ApplyTypeModifier(tt.typmod)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, v.String, "%d", i)
+	}
+
+	v := pgtype.BPChar{String: "abcdef", Status: pgtype.Present}
+	require.EqualError(t, v.ApplyTypeModifier(5+4), "value too long for type character(5)")
+}
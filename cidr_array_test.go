@@ -2,6 +2,7 @@ package pgtype_test
 
 import (
 	"net"
+	"net/netip"
 	"reflect"
 	"testing"
 
@@ -80,6 +81,24 @@ func TestCIDRArraySet(t *testing.T) {
 			source: (([]net.IP)(nil)),
 			result: pgtype.CIDRArray{Status: pgtype.Null},
 		},
+		{
+			source: []netip.Addr{netip.MustParseAddr("127.0.0.1")},
+			result: pgtype.CIDRArray{
+				Elements:   []pgtype.CIDR{{IPNet: mustParseCIDR(t, "127.0.0.1/32"), Status: pgtype.Present}},
+				Dimensions: []pgtype.ArrayDimension{{LowerBound: 1, Length: 1}},
+				Status:     pgtype.Present},
+		},
+		{
+			source: []*netip.Prefix{func(p netip.Prefix) *netip.Prefix { return &p }(netip.MustParsePrefix("10.0.0.0/8")), nil},
+			result: pgtype.CIDRArray{
+				Elements:   []pgtype.CIDR{{IPNet: mustParseCIDR(t, "10.0.0.0/8"), Status: pgtype.Present}, {Status: pgtype.Null}},
+				Dimensions: []pgtype.ArrayDimension{{LowerBound: 1, Length: 2}},
+				Status:     pgtype.Present},
+		},
+		{
+			source: (([]netip.Prefix)(nil)),
+			result: pgtype.CIDRArray{Status: pgtype.Null},
+		},
 		{
 			source: [][]net.IP{{mustParseCIDR(t, "127.0.0.1/32").IP}, {mustParseCIDR(t, "10.0.0.1/32").IP}},
 			result: pgtype.CIDRArray{
@@ -165,6 +184,8 @@ func TestCIDRArraySet(t *testing.T) {
 
 func TestCIDRArrayAssignTo(t *testing.T) {
 	var ipnetSlice []*net.IPNet
+	var addrSlice []netip.Addr
+	var prefixSlice []*netip.Prefix
 	var ipSlice []net.IP
 	var ipSliceDim2 [][]net.IP
 	var ipnetSliceDim4 [][][][]*net.IPNet
@@ -212,6 +233,24 @@ func TestCIDRArrayAssignTo(t *testing.T) {
 			dst:      &ipSlice,
 			expected: []net.IP{nil},
 		},
+		{
+			src: pgtype.CIDRArray{
+				Elements:   []pgtype.CIDR{{IPNet: mustParseCIDR(t, "127.0.0.1/32"), Status: pgtype.Present}},
+				Dimensions: []pgtype.ArrayDimension{{LowerBound: 1, Length: 1}},
+				Status:     pgtype.Present,
+			},
+			dst:      &addrSlice,
+			expected: []netip.Addr{netip.MustParseAddr("127.0.0.1")},
+		},
+		{
+			src: pgtype.CIDRArray{
+				Elements:   []pgtype.CIDR{{IPNet: mustParseCIDR(t, "10.0.0.0/8"), Status: pgtype.Present}, {Status: pgtype.Null}},
+				Dimensions: []pgtype.ArrayDimension{{LowerBound: 1, Length: 2}},
+				Status:     pgtype.Present,
+			},
+			dst:      &prefixSlice,
+			expected: []*netip.Prefix{func(p netip.Prefix) *netip.Prefix { return &p }(netip.MustParsePrefix("10.0.0.0/8")), nil},
+		},
 		{
 			src:      pgtype.CIDRArray{Status: pgtype.Null},
 			dst:      &ipnetSlice,
@@ -15,6 +15,29 @@ type CompositeTypeField struct {
 	OID  uint32
 }
 
+// CompositeStructMapping controls how a CompositeType matches Go struct fields to composite fields in Set and AssignTo.
+//
+// With CompositeStructStrict and CompositeStructLenient struct fields are matched by name. The name of a struct field is
+// the value of its db tag or the Go field name if it has none. A name that does not exactly match a composite field
+// matches case-insensitively. Unexported fields and fields tagged `db:"-"` are ignored. The fields of an embedded struct
+// without a db tag are matched as if they were fields of the outer struct.
+type CompositeStructMapping int8
+
+const (
+	// CompositeStructPositional matches the exported fields of a struct to composite fields by position. db tags and
+	// embedded structs are not treated specially. AssignTo does not treat a struct with a different number of exported
+	// fields as a composite and Set returns an error for it. This is the default.
+	CompositeStructPositional CompositeStructMapping = iota
+
+	// CompositeStructStrict matches fields by name and requires every composite field to match a struct field and every
+	// struct field to match a composite field.
+	CompositeStructStrict
+
+	// CompositeStructLenient matches fields by name and ignores unmatched fields. Composite fields without a struct field
+	// are skipped by AssignTo and set to NULL by Set.
+	CompositeStructLenient
+)
+
 type CompositeType struct {
 	status Status
 
@@ -22,6 +45,8 @@ type CompositeType struct {
 
 	fields           []CompositeTypeField
 	valueTranscoders []ValueTranscoder
+
+	structMapping CompositeStructMapping
 }
 
 // NewCompositeType creates a CompositeType from fields and ci. ci is used to find the ValueTranscoders used
@@ -57,6 +82,156 @@ func NewCompositeTypeValues(typeName string, fields []CompositeTypeField, values
 	return &CompositeType{typeName: typeName, fields: fields, valueTranscoders: values}, nil
 }
 
+// NewCompositeTypeFromStruct creates a CompositeType with a field for each field of a Go struct. structType is the
+// reflect.Type of the struct or a value of the struct or a pointer to it. Fields follow the same rules as
+// CompositeStructStrict, which is the struct mapping of the returned CompositeType. A field is named by its db tag or
+// else by its lower-cased Go name, the name PostgreSQL gives an unquoted identifier. Field OIDs are found with
+// ci.DataTypeForValue so nested structs can map to composite types that are already registered.
+//
+// If oid is not 0 the composite type is registered in ci with that OID and the struct type is registered as its
+// default Go type. Use CreateTypeSQL to get the DDL for a type that does not exist in the database yet.
+func NewCompositeTypeFromStruct(typeName string, oid uint32, structType interface{}, ci *ConnInfo) (*CompositeType, error) {
+	t, ok := structType.(reflect.Type)
+	if !ok {
+		t = reflect.TypeOf(structType)
+	}
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%v is not a struct", structType)
+	}
+
+	structFields := compositeStructFields(t, nil, nil)
+	fields := make([]CompositeTypeField, len(structFields))
+	for i, sf := range structFields {
+		ft := t.FieldByIndex(sf.index).Type
+
+		dt, ok := ci.DataTypeForValue(reflect.Zero(ft).Interface())
+		if !ok {
+			dt, ok = ci.DataTypeForValue(reflect.New(ft).Interface())
+		}
+		if !ok {
+			return nil, fmt.Errorf("no data type registered for field %s of type %v", sf.goName, ft)
+		}
+
+		name := sf.name
+		if !sf.tagged {
+			name = strings.ToLower(name)
+		}
+
+		fields[i] = CompositeTypeField{Name: name, OID: dt.OID}
+	}
+
+	ct, err := NewCompositeType(typeName, fields, ci)
+	if err != nil {
+		return nil, err
+	}
+	ct.structMapping = CompositeStructStrict
+
+	if oid != 0 {
+		ci.RegisterDataType(DataType{Value: ct, Name: typeName, OID: oid})
+		ci.RegisterDefaultPgType(reflect.Zero(t).Interface(), typeName)
+		ci.RegisterDefaultPgType(reflect.Zero(reflect.PtrTo(t)).Interface(), typeName)
+	}
+
+	return ct, nil
+}
+
+// CreateTypeSQL returns the CREATE TYPE statement for ct. ci is used to find the type names of the fields. Names are
+// quoted only when PostgreSQL requires it.
+func (ct *CompositeType) CreateTypeSQL(ci *ConnInfo) (string, error) {
+	sb := &strings.Builder{}
+	sb.WriteString("create type ")
+	sb.WriteString(quoteSQLTypeName(ct.typeName))
+	sb.WriteString(" as (")
+
+	for i, f := range ct.fields {
+		dt, ok := ci.DataTypeForOID(f.OID)
+		if !ok {
+			return "", fmt.Errorf("no data type registered for oid: %d", f.OID)
+		}
+
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString("\n\t")
+		sb.WriteString(quoteSQLIdentifier(f.Name))
+		sb.WriteByte(' ')
+		// Array types are named after their element type with a leading underscore.
+		if strings.HasPrefix(dt.Name, "_") {
+			sb.WriteString(quoteSQLTypeName(dt.Name[1:]))
+			sb.WriteString("[]")
+		} else {
+			sb.WriteString(quoteSQLTypeName(dt.Name))
+		}
+	}
+
+	sb.WriteString("\n)")
+	return sb.String(), nil
+}
+
+// quoteSQLIdentifier quotes s if it is not a valid unquoted identifier, following the rules of quote_ident.
+func quoteSQLIdentifier(s string) string {
+	safe := s != "" && !sqlKeywords[s]
+	for i, r := range s {
+		if !(r >= 'a' && r <= 'z' || r == '_' || i > 0 && r >= '0' && r <= '9') {
+			safe = false
+			break
+		}
+	}
+	if safe {
+		return s
+	}
+
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// sqlKeywords are the PostgreSQL keywords that are not unreserved. They must be quoted to be used as identifiers.
+var sqlKeywords = map[string]bool{
+	// reserved
+	"all": true, "analyse": true, "analyze": true, "and": true, "any": true, "array": true, "as": true, "asc": true,
+	"asymmetric": true, "both": true, "case": true, "cast": true, "check": true, "collate": true, "column": true,
+	"constraint": true, "create": true, "current_catalog": true, "current_date": true, "current_role": true,
+	"current_time": true, "current_timestamp": true, "current_user": true, "default": true, "deferrable": true,
+	"desc": true, "distinct": true, "do": true, "else": true, "end": true, "except": true, "false": true, "fetch": true,
+	"for": true, "foreign": true, "from": true, "grant": true, "group": true, "having": true, "in": true,
+	"initially": true, "intersect": true, "into": true, "lateral": true, "leading": true, "limit": true,
+	"localtime": true, "localtimestamp": true, "not": true, "null": true, "offset": true, "on": true, "only": true,
+	"or": true, "order": true, "placing": true, "primary": true, "references": true, "returning": true, "select": true,
+	"session_user": true, "some": true, "symmetric": true, "system_user": true, "table": true, "then": true, "to": true,
+	"trailing": true, "true": true, "union": true, "unique": true, "user": true, "using": true, "variadic": true,
+	"when": true, "where": true, "window": true, "with": true,
+
+	// type or function names
+	"authorization": true, "binary": true, "collation": true, "concurrently": true, "cross": true,
+	"current_schema": true, "freeze": true, "full": true, "ilike": true, "inner": true, "is": true, "isnull": true,
+	"join": true, "left": true, "like": true, "natural": true, "notnull": true, "outer": true, "overlaps": true,
+	"right": true, "similar": true, "tablesample": true, "verbose": true,
+
+	// column names
+	"between": true, "bigint": true, "bit": true, "boolean": true, "char": true, "character": true, "coalesce": true,
+	"dec": true, "decimal": true, "exists": true, "extract": true, "float": true, "greatest": true, "grouping": true,
+	"inout": true, "int": true, "integer": true, "interval": true, "json": true, "json_array": true,
+	"json_arrayagg": true, "json_exists": true, "json_object": true, "json_objectagg": true, "json_query": true,
+	"json_scalar": true, "json_serialize": true, "json_table": true, "json_value": true, "least": true,
+	"merge_action": true, "national": true, "nchar": true, "none": true, "normalize": true, "numeric": true,
+	"out": true, "overlay": true, "position": true, "precision": true, "real": true, "row": true, "setof": true,
+	"smallint": true, "substring": true, "time": true, "timestamp": true, "treat": true, "trim": true, "values": true,
+	"varchar": true, "xmlattributes": true, "xmlconcat": true, "xmlelement": true, "xmlexists": true,
+	"xmlforest": true, "xmlnamespaces": true, "xmlparse": true, "xmlpi": true, "xmlroot": true, "xmlserialize": true,
+	"xmltable": true,
+}
+
+// quoteSQLTypeName quotes each part of a possibly schema qualified type name.
+func quoteSQLTypeName(s string) string {
+	parts := strings.Split(s, ".")
+	for i := range parts {
+		parts[i] = quoteSQLIdentifier(parts[i])
+	}
+	return strings.Join(parts, ".")
+}
+
 func (src CompositeType) Get() interface{} {
 	switch src.status {
 	case Present:
@@ -77,6 +252,7 @@ func (ct *CompositeType) NewTypeValue() Value {
 		typeName:         ct.typeName,
 		fields:           ct.fields,
 		valueTranscoders: make([]ValueTranscoder, len(ct.valueTranscoders)),
+		structMapping:    ct.structMapping,
 	}
 
 	for i := range ct.valueTranscoders {
@@ -94,6 +270,17 @@ func (ct *CompositeType) Fields() []CompositeTypeField {
 	return ct.fields
 }
 
+// StructMapping returns how ct matches Go struct fields to composite fields.
+func (ct *CompositeType) StructMapping() CompositeStructMapping {
+	return ct.structMapping
+}
+
+// SetStructMapping sets how ct matches Go struct fields to composite fields. Values created by NewTypeValue inherit
+// the mapping.
+func (ct *CompositeType) SetStructMapping(m CompositeStructMapping) {
+	ct.structMapping = m
+}
+
 func (dst *CompositeType) Set(src interface{}) error {
 	if src == nil {
 		dst.status = Null
@@ -118,6 +305,9 @@ func (dst *CompositeType) Set(src interface{}) error {
 		}
 		return dst.Set(*value)
 	default:
+		if isStruct, err := dst.setStruct(src); isStruct {
+			return err
+		}
 		return fmt.Errorf("Can not convert %v to Composite", src)
 	}
 
@@ -196,28 +386,239 @@ func (src CompositeType) assignToPtrStruct(dst interface{}) (bool, error) {
 		return false, nil
 	}
 
-	exportedFields := make([]int, 0, dstElemType.NumField())
-	for i := 0; i < dstElemType.NumField(); i++ {
-		sf := dstElemType.Field(i)
-		if sf.PkgPath == "" {
-			exportedFields = append(exportedFields, i)
+	structFields, err := src.matchStructFields(dstElemType)
+	if err != nil {
+		return true, err
+	}
+	if structFields == nil {
+		return false, nil
+	}
+
+	for i, sf := range structFields {
+		if sf == nil {
+			continue
+		}
+
+		err := assignToOrSet(src.valueTranscoders[i], structFieldByIndexAlloc(dstElemValue, sf.index).Addr().Interface())
+		if err != nil {
+			return true, fmt.Errorf("unable to assign to field %s: %v", sf.goName, err)
 		}
 	}
 
-	if len(exportedFields) != len(src.valueTranscoders) {
+	return true, nil
+}
+
+// setStruct sets dst from the fields of src if src is a struct or a pointer to a struct.
+func (dst *CompositeType) setStruct(src interface{}) (bool, error) {
+	srcValue := reflect.ValueOf(src)
+	if srcValue.Kind() == reflect.Ptr {
+		if srcValue.IsNil() {
+			if srcValue.Type().Elem().Kind() != reflect.Struct {
+				return false, nil
+			}
+			dst.status = Null
+			return true, nil
+		}
+		srcValue = srcValue.Elem()
+	}
+
+	if srcValue.Kind() != reflect.Struct {
 		return false, nil
 	}
 
-	for i := range exportedFields {
-		err := assignToOrSet(src.valueTranscoders[i], dstElemValue.Field(exportedFields[i]).Addr().Interface())
-		if err != nil {
-			return true, fmt.Errorf("unable to assign to field %s: %v", dstElemType.Field(exportedFields[i]).Name, err)
+	structFields, err := dst.matchStructFields(srcValue.Type())
+	if err != nil {
+		return true, err
+	}
+	if structFields == nil {
+		return false, nil
+	}
+
+	for i, sf := range structFields {
+		var fieldValue interface{}
+		if sf != nil {
+			if v, ok := structFieldByIndex(srcValue, sf.index); ok {
+				fieldValue = v.Interface()
+			}
+		}
+
+		if err := dst.valueTranscoders[i].Set(fieldValue); err != nil {
+			return true, fmt.Errorf("unable to set field %s: %v", dst.fields[i].Name, err)
 		}
 	}
 
+	dst.status = Present
 	return true, nil
 }
 
+// compositeStructField is a Go struct field that can be matched to a composite field.
+type compositeStructField struct {
+	name   string
+	goName string
+	tagged bool
+	index  []int
+}
+
+// matchStructFields returns the struct field of t for each composite field. An element is nil when the composite
+// field has no struct field and the struct mapping is lenient. The result is nil when the struct mapping is positional
+// and t has a different number of exported fields.
+func (ct CompositeType) matchStructFields(t reflect.Type) ([]*compositeStructField, error) {
+	if ct.structMapping == CompositeStructPositional {
+		result := make([]*compositeStructField, 0, len(ct.fields))
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if sf.PkgPath == "" {
+				result = append(result, &compositeStructField{name: sf.Name, goName: sf.Name, index: []int{i}})
+			}
+		}
+		if len(result) != len(ct.fields) {
+			return nil, nil
+		}
+		return result, nil
+	}
+
+	structFields := compositeStructFields(t, nil, nil)
+	matched := make([]bool, len(structFields))
+	result := make([]*compositeStructField, len(ct.fields))
+
+	for i, f := range ct.fields {
+		match := -1
+		for j := range structFields {
+			if !matched[j] && structFields[j].name == f.Name {
+				match = j
+				break
+			}
+		}
+		if match == -1 {
+			for j := range structFields {
+				if !matched[j] && strings.EqualFold(structFields[j].name, f.Name) {
+					match = j
+					break
+				}
+			}
+		}
+
+		if match == -1 {
+			if ct.structMapping == CompositeStructStrict {
+				return nil, fmt.Errorf("%v has no field for composite field %s", t, f.Name)
+			}
+			continue
+		}
+
+		matched[match] = true
+		result[i] = &structFields[match]
+	}
+
+	if ct.structMapping == CompositeStructStrict {
+		for j := range structFields {
+			if !matched[j] {
+				return nil, fmt.Errorf("%v field %s does not match a composite field", t, structFields[j].goName)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// compositeStructFields appends the mappable fields of struct type t to fields. index is the index sequence of t
+// within the outermost struct. Fields of the outer struct take precedence over promoted fields with the same name.
+func compositeStructFields(t reflect.Type, index []int, fields []compositeStructField) []compositeStructField {
+	var embedded []reflect.StructField
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		tag, hasTag := sf.Tag.Lookup("db")
+		if name := strings.Split(tag, ",")[0]; hasTag && name != "" {
+			tag = name
+		} else {
+			hasTag = false
+		}
+		if tag == "-" {
+			continue
+		}
+
+		if sf.Anonymous && !hasTag {
+			ft := sf.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			// Fields of an unexported embedded struct are still promoted, but an unexported pointer cannot be allocated.
+			if ft.Kind() == reflect.Struct && (sf.PkgPath == "" || sf.Type.Kind() == reflect.Struct) {
+				embedded = append(embedded, sf)
+				continue
+			}
+		}
+
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		name := sf.Name
+		if hasTag {
+			name = tag
+		}
+
+		fields = append(fields, compositeStructField{
+			name:   name,
+			goName: sf.Name,
+			tagged: hasTag,
+			index:  append(append([]int{}, index...), i),
+		})
+	}
+
+	for _, sf := range embedded {
+		ft := sf.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		outer := len(fields)
+		for _, f := range compositeStructFields(ft, append(append([]int{}, index...), sf.Index...), nil) {
+			shadowed := false
+			for _, of := range fields[:outer] {
+				if of.name == f.name {
+					shadowed = true
+					break
+				}
+			}
+			if !shadowed {
+				fields = append(fields, f)
+			}
+		}
+	}
+
+	return fields
+}
+
+// structFieldByIndex returns the field of v at index. It returns false if a nil embedded pointer is on the path.
+func structFieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// structFieldByIndexAlloc returns the field of v at index allocating any nil embedded pointers on the path.
+func structFieldByIndexAlloc(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
 func (src CompositeType) EncodeBinary(ci *ConnInfo, buf []byte) (newBuf []byte, err error) {
 	switch src.status {
 	case Null:
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/jackc/pgtype"
@@ -159,6 +160,236 @@ func TestCompositeTypeAssignTo(t *testing.T) {
 	}
 }
 
+func TestCompositeTypeStructMapping(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	ct, err := pgtype.NewCompositeType("test", []pgtype.CompositeTypeField{
+		{"name", pgtype.TextOID},
+		{"item_count", pgtype.Int4OID},
+	}, ci)
+	require.NoError(t, err)
+
+	type positional struct {
+		Count int32
+		Label string
+	}
+
+	// Fields are matched by position by default so names do not matter.
+	assert.Equal(t, pgtype.CompositeStructPositional, ct.StructMapping())
+	require.NoError(t, ct.Set(struct {
+		Label string
+		Count int32
+	}{"foo", 42}))
+	assert.Equal(t, map[string]interface{}{"name": "foo", "item_count": int32(42)}, ct.Get())
+
+	var pdst struct {
+		A string
+		B int32
+	}
+	require.NoError(t, ct.AssignTo(&pdst))
+	assert.Equal(t, "foo", pdst.A)
+	assert.Equal(t, int32(42), pdst.B)
+
+	// A struct with a different number of exported fields is not treated as a composite.
+	require.Error(t, ct.Set(struct{ Name string }{"foo"}))
+	var single struct{ Name string }
+	require.Error(t, ct.AssignTo(&single))
+
+	// Mismatched field types are errors.
+	require.Error(t, ct.AssignTo(&positional{}))
+
+	ct.SetStructMapping(pgtype.CompositeStructStrict)
+
+	type Base struct {
+		Name string
+	}
+
+	type tagged struct {
+		Count   int32  `db:"item_count"`
+		Ignored string `db:"-"`
+		NAME    string
+		private int
+	}
+
+	type embedded struct {
+		*Base
+		ItemCount int32 `db:"item_count"`
+	}
+
+	type shadowed struct {
+		Base
+		Name      string
+		ItemCount int32 `db:"item_count"`
+	}
+
+	// Fields are matched by name regardless of order.
+	require.NoError(t, ct.Set(tagged{Count: 42, NAME: "foo", Ignored: "x"}))
+	assert.Equal(t, map[string]interface{}{"name": "foo", "item_count": int32(42)}, ct.Get())
+
+	var tdst tagged
+	require.NoError(t, ct.AssignTo(&tdst))
+	assert.Equal(t, tagged{Count: 42, NAME: "foo"}, tdst)
+
+	// Fields of embedded structs are promoted and nil embedded pointers are allocated.
+	var edst embedded
+	require.NoError(t, ct.AssignTo(&edst))
+	require.NotNil(t, edst.Base)
+	assert.Equal(t, "foo", edst.Name)
+	assert.Equal(t, int32(42), edst.ItemCount)
+
+	require.NoError(t, ct.Set(&embedded{Base: &Base{Name: "bar"}, ItemCount: 7}))
+	assert.Equal(t, map[string]interface{}{"name": "bar", "item_count": int32(7)}, ct.Get())
+
+	require.NoError(t, ct.Set(embedded{ItemCount: 7}))
+	assert.Equal(t, map[string]interface{}{"name": nil, "item_count": int32(7)}, ct.Get())
+
+	// Outer fields take precedence over promoted fields.
+	require.NoError(t, ct.Set(shadowed{Base: Base{Name: "inner"}, Name: "outer", ItemCount: 1}))
+	assert.Equal(t, map[string]interface{}{"name": "outer", "item_count": int32(1)}, ct.Get())
+
+	require.NoError(t, ct.Set((*tagged)(nil)))
+	assert.Nil(t, ct.Get())
+
+	type missing struct {
+		Name string
+	}
+
+	type extra struct {
+		Name      string
+		ItemCount int32 `db:"item_count"`
+		Other     string
+	}
+
+	// Unmatched fields are errors with strict mapping.
+	require.Error(t, ct.Set(missing{Name: "foo"}))
+	require.Error(t, ct.Set(extra{Name: "foo"}))
+
+	require.NoError(t, ct.Set(tagged{Count: 42, NAME: "foo"}))
+	var mdst missing
+	require.Error(t, ct.AssignTo(&mdst))
+	var xdst extra
+	require.Error(t, ct.AssignTo(&xdst))
+
+	// Lenient mapping skips them.
+	ct.SetStructMapping(pgtype.CompositeStructLenient)
+	lenient := ct.NewTypeValue().(*pgtype.CompositeType)
+	assert.Equal(t, pgtype.CompositeStructLenient, lenient.StructMapping())
+
+	require.NoError(t, lenient.Set(missing{Name: "foo"}))
+	assert.Equal(t, map[string]interface{}{"name": "foo", "item_count": nil}, lenient.Get())
+
+	require.NoError(t, lenient.Set(extra{Name: "foo", ItemCount: 3, Other: "x"}))
+	assert.Equal(t, map[string]interface{}{"name": "foo", "item_count": int32(3)}, lenient.Get())
+
+	require.NoError(t, lenient.AssignTo(&mdst))
+	assert.Equal(t, missing{Name: "foo"}, mdst)
+	xdst = extra{Other: "unchanged"}
+	require.NoError(t, lenient.AssignTo(&xdst))
+	assert.Equal(t, extra{Name: "foo", ItemCount: 3, Other: "unchanged"}, xdst)
+}
+
+func TestNewCompositeTypeFromStruct(t *testing.T) {
+	type Address struct {
+		Street string
+		Zip    *int32 `db:"zip_code"`
+	}
+
+	type Audit struct {
+		Tags []string
+	}
+
+	type Customer struct {
+		Name    string
+		Nick    string  `db:"Nick"`
+		Home    Address `db:"home_address"`
+		Balance pgtype.Numeric
+		Order   int32
+		Secret  string `db:"-"`
+		Audit
+	}
+
+	ci := pgtype.NewConnInfo()
+
+	addressCT, err := pgtype.NewCompositeTypeFromStruct("address", 100001, reflect.TypeOf(Address{}), ci)
+	require.NoError(t, err)
+	assert.Equal(t, []pgtype.CompositeTypeField{
+		{Name: "street", OID: pgtype.TextOID},
+		{Name: "zip_code", OID: pgtype.Int4OID},
+	}, addressCT.Fields())
+
+	dt, ok := ci.DataTypeForValue(Address{})
+	require.True(t, ok)
+	assert.Equal(t, uint32(100001), dt.OID)
+
+	customerCT, err := pgtype.NewCompositeTypeFromStruct("public.customer", 0, &Customer{}, ci)
+	require.NoError(t, err)
+	assert.Equal(t, []pgtype.CompositeTypeField{
+		{Name: "name", OID: pgtype.TextOID},
+		{Name: "Nick", OID: pgtype.TextOID},
+		{Name: "home_address", OID: 100001},
+		{Name: "balance", OID: pgtype.NumericOID},
+		{Name: "order", OID: pgtype.Int4OID},
+		{Name: "tags", OID: pgtype.TextArrayOID},
+	}, customerCT.Fields())
+	assert.Equal(t, pgtype.CompositeStructStrict, customerCT.StructMapping())
+	_, ok = ci.DataTypeForName("public.customer")
+	assert.False(t, ok)
+
+	sql, err := customerCT.CreateTypeSQL(ci)
+	require.NoError(t, err)
+	assert.Equal(t, `create type public.customer as (
+	name text,
+	"Nick" text,
+	home_address address,
+	balance "numeric",
+	"order" int4,
+	tags text[]
+)`, sql)
+
+	_, err = pgtype.NewCompositeTypeFromStruct("bad", 0, struct{ C chan int }{}, ci)
+	require.Error(t, err)
+	_, err = pgtype.NewCompositeTypeFromStruct("bad", 0, 42, ci)
+	require.Error(t, err)
+}
+
+func TestNewCompositeTypeFromStructTranscode(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	type point3 struct {
+		X, Y float64
+		Z    float64 `db:"z_value"`
+		Name *string
+	}
+
+	ct, err := pgtype.NewCompositeTypeFromStruct("ct_from_struct_test", 0, point3{}, conn.ConnInfo())
+	require.NoError(t, err)
+	sql, err := ct.CreateTypeSQL(conn.ConnInfo())
+	require.NoError(t, err)
+
+	_, err = conn.Exec(context.Background(), "drop type if exists ct_from_struct_test")
+	require.NoError(t, err)
+	_, err = conn.Exec(context.Background(), sql)
+	require.NoError(t, err)
+	defer conn.Exec(context.Background(), "drop type ct_from_struct_test")
+
+	var oid uint32
+	err = conn.QueryRow(context.Background(), `select 'ct_from_struct_test'::regtype::oid`).Scan(&oid)
+	require.NoError(t, err)
+
+	_, err = pgtype.NewCompositeTypeFromStruct("ct_from_struct_test", oid, point3{}, conn.ConnInfo())
+	require.NoError(t, err)
+
+	name := "p"
+	var result point3
+	err = conn.QueryRow(context.Background(), "select $1::ct_from_struct_test", point3{X: 1, Y: 2, Z: 3, Name: &name}).Scan(&result)
+	require.NoError(t, err)
+	assert.Equal(t, point3{X: 1, Y: 2, Z: 3, Name: &name}, result)
+
+	err = conn.QueryRow(context.Background(), "select row(1, 2, 3, null)::ct_from_struct_test").Scan(&result)
+	require.NoError(t, err)
+	assert.Equal(t, point3{X: 1, Y: 2, Z: 3}, result)
+}
+
 func TestCompositeTypeTranscode(t *testing.T) {
 	conn := testutil.MustConnectPgx(t)
 	defer testutil.MustCloseContext(t, conn)
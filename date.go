@@ -6,7 +6,6 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/jackc/pgio"
@@ -139,21 +138,15 @@ func (dst *Date) DecodeText(ci *ConnInfo, src []byte) error {
 	case "-infinity":
 		*dst = Date{Status: Present, InfinityModifier: -Infinity}
 	default:
-		if strings.HasSuffix(sbuf, " BC") {
-			t, err := time.ParseInLocation("2006-01-02", strings.TrimRight(sbuf, " BC"), time.UTC)
-			t2 := time.Date(1-t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
-			if err != nil {
-				return err
-			}
-			*dst = Date{Time: t2, Status: Present}
-			return nil
-		}
-		t, err := time.ParseInLocation("2006-01-02", sbuf, time.UTC)
+		f, err := parseDateTimeText(ci, sbuf)
 		if err != nil {
 			return err
 		}
+		if f.hasTime || f.zone != "" {
+			return fmt.Errorf("invalid date: %q", sbuf)
+		}
 
-		*dst = Date{Time: t, Status: Present}
+		*dst = Date{Time: f.time(time.UTC), Status: Present}
 	}
 
 	return nil
@@ -196,7 +189,7 @@ func (src Date) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
 
 	switch src.InfinityModifier {
 	case None:
-		s = src.Time.Format("2006-01-02")
+		return appendISODateTime(buf, src.Time, false, false), nil
 	case Infinity:
 		s = "infinity"
 	case NegativeInfinity:
@@ -9,6 +9,7 @@ import (
 
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/require"
 )
 
 type customDate struct {
@@ -50,6 +51,10 @@ func TestDateTranscode(t *testing.T) {
 		&pgtype.Date{Time: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
 		&pgtype.Date{Time: time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
 		&pgtype.Date{Time: time.Date(2200, 1, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+		&pgtype.Date{Time: time.Date(-4713, 11, 24, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+		&pgtype.Date{Time: time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+		&pgtype.Date{Time: time.Date(12345, 6, 7, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+		&pgtype.Date{Time: time.Date(5874897, 12, 31, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
 		&pgtype.Date{Status: pgtype.Null},
 		&pgtype.Date{Status: pgtype.Present, InfinityModifier: pgtype.Infinity},
 		&pgtype.Date{Status: pgtype.Present, InfinityModifier: -pgtype.Infinity},
@@ -202,3 +207,44 @@ func TestDateUnmarshalJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestDateTextFullRange(t *testing.T) {
+	tests := []struct {
+		time time.Time
+		text string
+	}{
+		{time: time.Date(-4713, 11, 24, 0, 0, 0, 0, time.UTC), text: "4714-11-24 BC"},
+		{time: time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC), text: "0044-03-15 BC"},
+		{time: time.Date(0, 12, 31, 0, 0, 0, 0, time.UTC), text: "0001-12-31 BC"},
+		{time: time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), text: "0001-01-01"},
+		{time: time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC), text: "2000-02-29"},
+		{time: time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC), text: "10000-01-01"},
+		{time: time.Date(5874897, 12, 31, 0, 0, 0, 0, time.UTC), text: "5874897-12-31"},
+	}
+
+	for i, tt := range tests {
+		src := pgtype.Date{Time: tt.time, Status: pgtype.Present}
+
+		buf, err := src.EncodeText(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.text, string(buf), "%d", i)
+
+		var dst pgtype.Date
+		err = dst.DecodeText(nil, buf)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, src, dst, "%d", i)
+
+		buf, err = src.EncodeBinary(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+		dst = pgtype.Date{}
+		err = dst.DecodeBinary(nil, buf)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, src, dst, "%d", i)
+	}
+
+	for i, s := range []string{"0000-01-01 BC", "2001-02-29", "2000-13-01", "20-01-01x", "2000-01-01 BCE"} {
+		var dst pgtype.Date
+		err := dst.DecodeText(nil, []byte(s))
+		require.Errorf(t, err, "%d", i)
+	}
+}
@@ -265,3 +265,182 @@ func (dst *Daterange) Scan(src interface{}) error {
 func (src Daterange) Value() (driver.Value, error) {
 	return EncodeValueText(src)
 }
+
+func (src Daterange) rangeValue() (rangeValue[Date], error) {
+	return newRangeValue(src.Lower, src.Upper, src.LowerType, src.UpperType, dateRangeElementOps)
+}
+
+func newDaterangeFromRangeValue(r rangeValue[Date]) Daterange {
+	dst := Daterange{Status: Present}
+	dst.LowerType, dst.UpperType = r.boundTypes()
+	if dst.LowerType == Inclusive || dst.LowerType == Exclusive {
+		dst.Lower = r.lower.value
+	}
+	if dst.UpperType == Inclusive || dst.UpperType == Exclusive {
+		dst.Upper = r.upper.value
+	}
+	return dst
+}
+
+// Canonicalize returns src in the canonical form PostgreSQL uses. A range that contains no values is empty and a
+// range of a discrete element type has an inclusive lower bound and an exclusive upper bound.
+func (src Daterange) Canonicalize() (Daterange, error) {
+	if src.Status != Present {
+		return Daterange{Status: src.Status}, nil
+	}
+
+	r, err := src.rangeValue()
+	if err != nil {
+		return Daterange{}, err
+	}
+	return newDaterangeFromRangeValue(r), nil
+}
+
+// rangeValuePair returns the range values of src and x.
+func (src Daterange) rangeValuePair(x Daterange) (rangeValue[Date], rangeValue[Date], error) {
+	r, err := src.rangeValue()
+	if err != nil {
+		return r, r, err
+	}
+	xr, err := x.rangeValue()
+	return r, xr, err
+}
+
+// IsEmpty reports whether src contains no values. It is an error if src is not a valid range.
+func (src Daterange) IsEmpty() (bool, error) {
+	if src.Status != Present {
+		return false, nil
+	}
+	r, err := src.rangeValue()
+	if err != nil {
+		return false, err
+	}
+	return r.empty, nil
+}
+
+// Contains reports whether src contains elem like the PostgreSQL @> operator. It is an error if src is not a valid
+// range.
+func (src Daterange) Contains(elem Date) (bool, error) {
+	if src.Status != Present || elem.Status != Present {
+		return false, nil
+	}
+	r, err := src.rangeValue()
+	if err != nil {
+		return false, err
+	}
+	return r.containsElem(elem, dateRangeElementOps), nil
+}
+
+// ContainsRange reports whether src contains x like the PostgreSQL @> operator. It is an error if either range is
+// not a valid range.
+func (src Daterange) ContainsRange(x Daterange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.containsRange(xr, dateRangeElementOps), nil
+}
+
+// Overlaps reports whether src and x have values in common like the PostgreSQL && operator. It is an error if either
+// range is not a valid range.
+func (src Daterange) Overlaps(x Daterange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.overlaps(xr, dateRangeElementOps), nil
+}
+
+// Adjacent reports whether src and x are adjacent like the PostgreSQL -|- operator. It is an error if either range is
+// not a valid range.
+func (src Daterange) Adjacent(x Daterange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.adjacent(xr, dateRangeElementOps), nil
+}
+
+// StrictlyLeftOf reports whether all values of src are less than all values of x like the PostgreSQL << operator. It
+// is an error if either range is not a valid range.
+func (src Daterange) StrictlyLeftOf(x Daterange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.before(xr, dateRangeElementOps), nil
+}
+
+// StrictlyRightOf reports whether all values of src are greater than all values of x like the PostgreSQL >>
+// operator. It is an error if either range is not a valid range.
+func (src Daterange) StrictlyRightOf(x Daterange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.after(xr, dateRangeElementOps), nil
+}
+
+// Union returns the union of src and x like the PostgreSQL + operator. It is an error if the ranges neither overlap
+// nor are adjacent. If either range is not Present the result is Null.
+func (src Daterange) Union(x Daterange) (Daterange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Daterange{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Daterange{}, err
+	}
+
+	result, err := r.union(xr, dateRangeElementOps)
+	if err != nil {
+		return Daterange{}, err
+	}
+	return newDaterangeFromRangeValue(result), nil
+}
+
+// Intersect returns the intersection of src and x like the PostgreSQL * operator. If either range is not Present the
+// result is Null. It is an error if either range is not a valid range.
+func (src Daterange) Intersect(x Daterange) (Daterange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Daterange{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Daterange{}, err
+	}
+	return newDaterangeFromRangeValue(r.intersect(xr, dateRangeElementOps)), nil
+}
+
+// Difference returns the values of src that are not in x like the PostgreSQL - operator. It is an error if x is
+// strictly inside src so the result would be two ranges. If either range is not Present the result is Null.
+func (src Daterange) Difference(x Daterange) (Daterange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Daterange{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Daterange{}, err
+	}
+
+	result, err := r.difference(xr, dateRangeElementOps)
+	if err != nil {
+		return Daterange{}, err
+	}
+	return newDaterangeFromRangeValue(result), nil
+}
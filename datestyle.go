@@ -0,0 +1,550 @@
+package pgtype
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateStyleFormat is the output format part of the PostgreSQL DateStyle setting.
+type DateStyleFormat int8
+
+const (
+	DateStyleISO DateStyleFormat = iota
+	DateStyleSQL
+	DateStylePostgres
+	DateStyleGerman
+)
+
+func (f DateStyleFormat) String() string {
+	switch f {
+	case DateStyleISO:
+		return "ISO"
+	case DateStyleSQL:
+		return "SQL"
+	case DateStylePostgres:
+		return "Postgres"
+	case DateStyleGerman:
+		return "German"
+	default:
+		return fmt.Sprintf("invalid DateStyleFormat (%d)", int8(f))
+	}
+}
+
+// DateOrder is the field order part of the PostgreSQL DateStyle setting.
+type DateOrder int8
+
+const (
+	DateOrderMDY DateOrder = iota
+	DateOrderDMY
+	DateOrderYMD
+)
+
+func (o DateOrder) String() string {
+	switch o {
+	case DateOrderMDY:
+		return "MDY"
+	case DateOrderDMY:
+		return "DMY"
+	case DateOrderYMD:
+		return "YMD"
+	default:
+		return fmt.Sprintf("invalid DateOrder (%d)", int8(o))
+	}
+}
+
+// DateStyle is the PostgreSQL DateStyle setting. The zero value is the PostgreSQL default of ISO, MDY.
+type DateStyle struct {
+	Format DateStyleFormat
+	Order  DateOrder
+}
+
+func (ds DateStyle) String() string {
+	return ds.Format.String() + ", " + ds.Order.String()
+}
+
+// ParseDateStyle parses a DateStyle setting such as "ISO, MDY" or "SQL, DMY" as reported by the server in the DateStyle
+// parameter status. The same keywords and defaults as the server's SET datestyle are accepted.
+func ParseDateStyle(s string) (DateStyle, error) {
+	var ds DateStyle
+	var haveFormat, haveOrder bool
+
+	for _, tok := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' }) {
+		switch strings.ToUpper(tok) {
+		case "ISO":
+			ds.Format, haveFormat = DateStyleISO, true
+		case "SQL":
+			ds.Format, haveFormat = DateStyleSQL, true
+		case "POSTGRES":
+			ds.Format, haveFormat = DateStylePostgres, true
+		case "GERMAN":
+			ds.Format, haveFormat = DateStyleGerman, true
+			if !haveOrder {
+				ds.Order = DateOrderDMY
+			}
+		case "MDY", "US", "NONEURO", "NONEUROPEAN":
+			ds.Order, haveOrder = DateOrderMDY, true
+		case "DMY", "EURO", "EUROPEAN":
+			ds.Order, haveOrder = DateOrderDMY, true
+		case "YMD":
+			ds.Order, haveOrder = DateOrderYMD, true
+		case "DEFAULT":
+		default:
+			return DateStyle{}, fmt.Errorf("invalid DateStyle: %q", s)
+		}
+	}
+
+	if !haveFormat && !haveOrder {
+		return DateStyle{}, fmt.Errorf("invalid DateStyle: %q", s)
+	}
+
+	return ds, nil
+}
+
+// SetDateStyle sets the DateStyle used to interpret the text format of date, timestamp and timestamptz. The output
+// format is recognized from the text itself, so only the field order is needed to disambiguate SQL and Postgres style
+// dates such as 01/02/2006.
+func (ci *ConnInfo) SetDateStyle(ds DateStyle) {
+	ci.dateStyle = ds
+}
+
+// DateStyle returns the DateStyle set with SetDateStyle or SetParameterStatus.
+func (ci *ConnInfo) DateStyle() DateStyle {
+	return ci.dateStyle
+}
+
+// SetTimeZone sets the session time zone used to resolve time zone abbreviations such as PST in the SQL, Postgres and
+// German timestamptz output formats. Without a time zone only UTC and a small set of common abbreviations are
+// recognized.
+func (ci *ConnInfo) SetTimeZone(loc *time.Location) {
+	ci.timeZone = loc
+}
+
+// TimeZone returns the time zone set with SetTimeZone or SetParameterStatus. It is nil if none was set.
+func (ci *ConnInfo) TimeZone() *time.Location {
+	return ci.timeZone
+}
+
+// SetParameterStatus configures ci from a server parameter status. The DateStyle and TimeZone parameters are used;
+// all others are ignored. A TimeZone that is not known to the time package is ignored as well. e.g.
+//
+//	ci.SetParameterStatus("DateStyle", conn.PgConn().ParameterStatus("DateStyle"))
+//	ci.SetParameterStatus("TimeZone", conn.PgConn().ParameterStatus("TimeZone"))
+func (ci *ConnInfo) SetParameterStatus(name, value string) error {
+	switch strings.ToLower(name) {
+	case "datestyle":
+		ds, err := ParseDateStyle(value)
+		if err != nil {
+			return err
+		}
+		ci.dateStyle = ds
+	case "timezone":
+		loc, err := time.LoadLocation(value)
+		if err != nil {
+			ci.timeZone = nil
+			return nil
+		}
+		ci.timeZone = loc
+	}
+
+	return nil
+}
+
+func dateOrderFromConnInfo(ci *ConnInfo) DateOrder {
+	if ci == nil {
+		return DateOrderMDY
+	}
+	return ci.dateStyle.Order
+}
+
+// dateTimeFields are the fields of a date, timestamp or timestamptz in text format.
+type dateTimeFields struct {
+	year, month, day                 int
+	hour, minute, second, nanosecond int
+	hasTime                          bool
+	zone                             string
+	bc                               bool
+}
+
+// isISODateText reports whether s starts with an ISO style date. All other DateStyle formats start with a day name or
+// a one or two digit field.
+func isISODateText(s string) bool {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return i >= 4 && i < len(s) && s[i] == '-'
+}
+
+var monthAbbreviations = map[string]time.Month{
+	"jan": time.January, "feb": time.February, "mar": time.March, "apr": time.April,
+	"may": time.May, "jun": time.June, "jul": time.July, "aug": time.August,
+	"sep": time.September, "oct": time.October, "nov": time.November, "dec": time.December,
+}
+
+// parseDateTimeText parses s in any DateStyle format. The date order of ci resolves ambiguous SQL and Postgres style
+// dates.
+func parseDateTimeText(ci *ConnInfo, s string) (dateTimeFields, error) {
+	if isISODateText(s) {
+		return parseISODateTimeText(s)
+	}
+	return parseDateStyleText(s, dateOrderFromConnInfo(ci))
+}
+
+// parseDateStyleText parses the SQL, Postgres and German DateStyle output formats. order resolves whether the day or
+// the month comes first where the text does not say.
+func parseDateStyleText(s string, order DateOrder) (dateTimeFields, error) {
+	var f dateTimeFields
+
+	if strings.HasSuffix(s, " BC") {
+		f.bc = true
+		s = s[:len(s)-3]
+	}
+
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return f, fmt.Errorf("invalid date: %q", s)
+	}
+
+	var err error
+	var rest []string
+
+	if c := fields[0][0]; (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') {
+		// Postgres style: Wed Dec 17 07:37:16 1997 PST or, with DMY, Wed 17 Dec 07:37:16 1997 PST
+		if len(fields) < 5 {
+			return f, fmt.Errorf("invalid timestamp: %q", s)
+		}
+
+		monthText, dayText := fields[1], fields[2]
+		if _, ok := monthAbbreviations[strings.ToLower(fields[2])]; ok {
+			monthText, dayText = fields[2], fields[1]
+		}
+		month, ok := monthAbbreviations[strings.ToLower(monthText)]
+		if !ok {
+			return f, fmt.Errorf("invalid month in timestamp: %q", s)
+		}
+		f.month = int(month)
+		if f.day, err = strconv.Atoi(dayText); err != nil {
+			return f, fmt.Errorf("invalid day in timestamp: %q", s)
+		}
+		if err = f.parseTime(fields[3]); err != nil {
+			return f, err
+		}
+		if f.year, err = strconv.Atoi(fields[4]); err != nil {
+			return f, fmt.Errorf("invalid year in timestamp: %q", s)
+		}
+		rest = fields[5:]
+	} else {
+		// SQL style 12/17/1997, German style 17.12.1997 or Postgres style 12-17-1997, each optionally followed by a time
+		// and a time zone.
+		dateText := fields[0]
+		sep := strings.IndexAny(dateText, "/.-")
+		if sep < 0 {
+			return f, fmt.Errorf("invalid date: %q", s)
+		}
+		parts := strings.Split(dateText, dateText[sep:sep+1])
+		if len(parts) != 3 {
+			return f, fmt.Errorf("invalid date: %q", s)
+		}
+		var n [3]int
+		for i, p := range parts {
+			if n[i], err = strconv.Atoi(p); err != nil || p[0] == '-' || p[0] == '+' {
+				return f, fmt.Errorf("invalid date: %q", s)
+			}
+		}
+
+		if dateText[sep] == '.' || order == DateOrderDMY {
+			f.day, f.month, f.year = n[0], n[1], n[2]
+		} else {
+			f.month, f.day, f.year = n[0], n[1], n[2]
+		}
+
+		rest = fields[1:]
+		if len(rest) > 0 {
+			if err = f.parseTime(rest[0]); err != nil {
+				return f, err
+			}
+			rest = rest[1:]
+		}
+	}
+
+	switch len(rest) {
+	case 0:
+	case 1:
+		f.zone = rest[0]
+	default:
+		return f, fmt.Errorf("invalid timestamp: %q", s)
+	}
+
+	if err := f.finish(); err != nil {
+		return f, fmt.Errorf("%v: %q", err, s)
+	}
+
+	return f, nil
+}
+
+// parseISODateTimeText parses the ISO DateStyle format, e.g. 1997-12-17 07:37:16.123456-08. Years may have more than
+// four digits and a trailing BC is understood.
+func parseISODateTimeText(s string) (dateTimeFields, error) {
+	var f dateTimeFields
+	text := s
+
+	if strings.HasSuffix(s, " BC") {
+		f.bc = true
+		s = s[:len(s)-3]
+	}
+
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i < 4 || len(s) < i+6 || s[i] != '-' || s[i+3] != '-' {
+		return f, fmt.Errorf("invalid date: %q", text)
+	}
+
+	var err error
+	if f.year, err = strconv.Atoi(s[:i]); err != nil {
+		return f, fmt.Errorf("invalid year: %q", text)
+	}
+	if f.month, err = parseTwoDigits(s[i+1 : i+3]); err != nil {
+		return f, fmt.Errorf("invalid month: %q", text)
+	}
+	if f.day, err = parseTwoDigits(s[i+4 : i+6]); err != nil {
+		return f, fmt.Errorf("invalid day: %q", text)
+	}
+	s = s[i+6:]
+
+	if len(s) > 0 {
+		if s[0] != ' ' && s[0] != 'T' {
+			return f, fmt.Errorf("invalid timestamp: %q", text)
+		}
+		s = s[1:]
+
+		timeText := s
+		if z := strings.IndexAny(s, "+-Z"); z >= 0 {
+			timeText, f.zone = s[:z], s[z:]
+		}
+		if err := f.parseTime(timeText); err != nil {
+			return f, err
+		}
+	}
+
+	if err := f.finish(); err != nil {
+		return f, fmt.Errorf("%v: %q", err, text)
+	}
+
+	return f, nil
+}
+
+func parseTwoDigits(s string) (int, error) {
+	if len(s) != 2 || s[0] < '0' || s[0] > '9' || s[1] < '0' || s[1] > '9' {
+		return 0, fmt.Errorf("invalid digits: %q", s)
+	}
+	return int(s[0]-'0')*10 + int(s[1]-'0'), nil
+}
+
+// finish validates the date and converts a BC year to the astronomical year numbering used by time.Time where 1 BC
+// is year 0.
+func (f *dateTimeFields) finish() error {
+	if f.month < 1 || f.month > 12 || f.day < 1 {
+		return fmt.Errorf("invalid date")
+	}
+
+	if f.bc {
+		if f.year < 1 {
+			return fmt.Errorf("invalid year")
+		}
+		f.year = 1 - f.year
+	}
+
+	if f.day > time.Date(f.year, time.Month(f.month)+1, 0, 0, 0, 0, 0, time.UTC).Day() {
+		return fmt.Errorf("day out of range")
+	}
+
+	return nil
+}
+
+// appendISODateTime appends t in the ISO DateStyle format. Years before 1 AD are written with a BC suffix. The time of
+// day is included if withTime is set with up to microsecond precision. The zone is appended as Z and is only
+// meaningful for a time in UTC.
+func appendISODateTime(buf []byte, t time.Time, withTime, withZone bool) []byte {
+	year := t.Year()
+	bc := year < 1
+	if bc {
+		year = 1 - year
+	}
+
+	buf = appendPaddedInt(buf, year, 4)
+	buf = append(buf, '-')
+	buf = appendPaddedInt(buf, int(t.Month()), 2)
+	buf = append(buf, '-')
+	buf = appendPaddedInt(buf, t.Day(), 2)
+
+	if withTime {
+		buf = append(buf, ' ')
+		buf = appendPaddedInt(buf, t.Hour(), 2)
+		buf = append(buf, ':')
+		buf = appendPaddedInt(buf, t.Minute(), 2)
+		buf = append(buf, ':')
+		buf = appendPaddedInt(buf, t.Second(), 2)
+
+		if usec := t.Nanosecond() / 1000; usec != 0 {
+			frac := []byte{'.', 0, 0, 0, 0, 0, 0}
+			for i := 6; i > 0; i-- {
+				frac[i] = byte('0' + usec%10)
+				usec /= 10
+			}
+			buf = append(buf, bytes.TrimRight(frac, "0")...)
+		}
+	}
+
+	if withZone {
+		buf = append(buf, 'Z')
+	}
+
+	if bc {
+		buf = append(buf, " BC"...)
+	}
+
+	return buf
+}
+
+func appendPaddedInt(buf []byte, n int, width int) []byte {
+	s := strconv.Itoa(n)
+	for i := len(s); i < width; i++ {
+		buf = append(buf, '0')
+	}
+	return append(buf, s...)
+}
+
+// parseTime parses hh:mm:ss with an optional fraction of up to nanosecond precision.
+func (f *dateTimeFields) parseTime(s string) error {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return fmt.Errorf("invalid time: %q", s)
+	}
+
+	var err error
+	if f.hour, err = strconv.Atoi(parts[0]); err != nil || f.hour < 0 || f.hour > 24 {
+		return fmt.Errorf("invalid hour: %q", s)
+	}
+	if f.minute, err = strconv.Atoi(parts[1]); err != nil || f.minute < 0 || f.minute > 59 {
+		return fmt.Errorf("invalid minute: %q", s)
+	}
+
+	secText := parts[2]
+	if dot := strings.IndexByte(secText, '.'); dot >= 0 {
+		frac := secText[dot+1:]
+		secText = secText[:dot]
+		if len(frac) == 0 || len(frac) > 9 {
+			return fmt.Errorf("invalid fractional seconds: %q", s)
+		}
+		nsec, err := strconv.ParseUint(frac+strings.Repeat("0", 9-len(frac)), 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid fractional seconds: %q", s)
+		}
+		f.nanosecond = int(nsec)
+	}
+	if f.second, err = strconv.Atoi(secText); err != nil || f.second < 0 || f.second > 60 {
+		return fmt.Errorf("invalid second: %q", s)
+	}
+
+	f.hasTime = true
+	return nil
+}
+
+// time returns the fields as a time in loc.
+func (f *dateTimeFields) time(loc *time.Location) time.Time {
+	return time.Date(f.year, time.Month(f.month), f.day, f.hour, f.minute, f.second, f.nanosecond, loc)
+}
+
+// zoneAbbreviationOffsets are the UTC offsets in seconds of time zone abbreviations that can be resolved without a
+// session time zone.
+var zoneAbbreviationOffsets = map[string]int{
+	"UTC": 0, "UT": 0, "GMT": 0, "Z": 0, "ZULU": 0,
+	"EST": -5 * 3600, "EDT": -4 * 3600,
+	"CST": -6 * 3600, "CDT": -5 * 3600,
+	"MST": -7 * 3600, "MDT": -6 * 3600,
+	"PST": -8 * 3600, "PDT": -7 * 3600,
+	"AKST": -9 * 3600, "AKDT": -8 * 3600,
+	"HST": -10 * 3600,
+	"WET": 0, "WEST": 1 * 3600,
+	"BST": 1 * 3600,
+	"CET": 1 * 3600, "CEST": 2 * 3600,
+	"EET": 2 * 3600, "EEST": 3 * 3600,
+	"MSK": 3 * 3600,
+	"JST": 9 * 3600, "KST": 9 * 3600,
+	"AWST": 8 * 3600,
+	"ACST": 9*3600 + 1800, "ACDT": 10*3600 + 1800,
+	"AEST": 10 * 3600, "AEDT": 11 * 3600,
+	"NZST": 12 * 3600, "NZDT": 13 * 3600,
+}
+
+// zonedTime returns the fields as a time in their time zone. Numeric offsets such as +05:30 are always understood.
+// Abbreviations are resolved with the session time zone of ci if it uses the abbreviation around that time and
+// otherwise with zoneAbbreviationOffsets.
+func (f *dateTimeFields) zonedTime(ci *ConnInfo) (time.Time, error) {
+	if f.zone == "" {
+		return time.Time{}, fmt.Errorf("missing time zone")
+	}
+
+	if c := f.zone[0]; c == '+' || c == '-' {
+		offset, err := parseZoneOffset(f.zone)
+		if err != nil {
+			return time.Time{}, err
+		}
+		// Like time.Parse use the local time zone when it has the same offset at that time.
+		t := f.time(time.FixedZone("", offset))
+		if _, localOffset := t.In(time.Local).Zone(); localOffset == offset {
+			return t.In(time.Local), nil
+		}
+		return t, nil
+	}
+
+	if ci != nil && ci.timeZone != nil {
+		wall := f.time(ci.timeZone)
+		for _, probe := range []time.Time{wall, wall.Add(-24 * time.Hour), wall.Add(24 * time.Hour)} {
+			if name, offset := probe.Zone(); name == f.zone {
+				return f.time(time.FixedZone(name, offset)).In(ci.timeZone), nil
+			}
+		}
+	}
+
+	if offset, ok := zoneAbbreviationOffsets[strings.ToUpper(f.zone)]; ok {
+		if offset == 0 {
+			return f.time(time.UTC), nil
+		}
+		return f.time(time.FixedZone(f.zone, offset)), nil
+	}
+
+	return time.Time{}, fmt.Errorf("unknown time zone: %q", f.zone)
+}
+
+// parseZoneOffset parses a numeric UTC offset of the form +hh, +hh:mm or +hh:mm:ss.
+func parseZoneOffset(s string) (int, error) {
+	sign := 1
+	if s[0] == '-' {
+		sign = -1
+	}
+
+	parts := strings.Split(s[1:], ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("invalid time zone offset: %q", s)
+	}
+
+	offset := 0
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || len(p) != 2 || n < 0 || n > 59 && i > 0 {
+			return 0, fmt.Errorf("invalid time zone offset: %q", s)
+		}
+		offset = offset*60 + n
+	}
+	for i := len(parts); i < 3; i++ {
+		offset *= 60
+	}
+
+	return sign * offset, nil
+}
@@ -0,0 +1,216 @@
+package pgtype_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/testutil"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDateStyle(t *testing.T) {
+	successfulTests := []struct {
+		s  string
+		ds pgtype.DateStyle
+	}{
+		{s: "ISO, MDY", ds: pgtype.DateStyle{Format: pgtype.DateStyleISO, Order: pgtype.DateOrderMDY}},
+		{s: "SQL, DMY", ds: pgtype.DateStyle{Format: pgtype.DateStyleSQL, Order: pgtype.DateOrderDMY}},
+		{s: "Postgres, YMD", ds: pgtype.DateStyle{Format: pgtype.DateStylePostgres, Order: pgtype.DateOrderYMD}},
+		{s: "German, DMY", ds: pgtype.DateStyle{Format: pgtype.DateStyleGerman, Order: pgtype.DateOrderDMY}},
+		{s: "German", ds: pgtype.DateStyle{Format: pgtype.DateStyleGerman, Order: pgtype.DateOrderDMY}},
+		{s: "german, mdy", ds: pgtype.DateStyle{Format: pgtype.DateStyleGerman, Order: pgtype.DateOrderMDY}},
+		{s: "European", ds: pgtype.DateStyle{Format: pgtype.DateStyleISO, Order: pgtype.DateOrderDMY}},
+	}
+	for i, tt := range successfulTests {
+		ds, err := pgtype.ParseDateStyle(tt.s)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.ds, ds, "%d", i)
+	}
+
+	for i, s := range []string{"", "ISO, XYZ", "Julian"} {
+		_, err := pgtype.ParseDateStyle(s)
+		require.Errorf(t, err, "%d", i)
+	}
+
+	require.Equal(t, "SQL, DMY", pgtype.DateStyle{Format: pgtype.DateStyleSQL, Order: pgtype.DateOrderDMY}.String())
+}
+
+func TestConnInfoSetParameterStatus(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	require.Equal(t, pgtype.DateStyle{}, ci.DateStyle())
+	require.Nil(t, ci.TimeZone())
+
+	require.NoError(t, ci.SetParameterStatus("DateStyle", "SQL, DMY"))
+	require.NoError(t, ci.SetParameterStatus("TimeZone", "UTC"))
+	require.NoError(t, ci.SetParameterStatus("application_name", "test"))
+	require.Equal(t, pgtype.DateStyle{Format: pgtype.DateStyleSQL, Order: pgtype.DateOrderDMY}, ci.DateStyle())
+	require.Equal(t, time.UTC, ci.TimeZone())
+
+	ci2 := ci.DeepCopy()
+	require.Equal(t, ci.DateStyle(), ci2.DateStyle())
+	require.Equal(t, ci.TimeZone(), ci2.TimeZone())
+
+	require.Error(t, ci.SetParameterStatus("DateStyle", "bogus"))
+	require.NoError(t, ci.SetParameterStatus("TimeZone", "<+05>-05"))
+	require.Nil(t, ci.TimeZone())
+}
+
+func TestDateDecodeTextDateStyles(t *testing.T) {
+	tests := []struct {
+		order  pgtype.DateOrder
+		src    string
+		result time.Time
+	}{
+		{order: pgtype.DateOrderMDY, src: "12/17/1997", result: time.Date(1997, 12, 17, 0, 0, 0, 0, time.UTC)},
+		{order: pgtype.DateOrderDMY, src: "17/12/1997", result: time.Date(1997, 12, 17, 0, 0, 0, 0, time.UTC)},
+		{order: pgtype.DateOrderYMD, src: "12/17/1997", result: time.Date(1997, 12, 17, 0, 0, 0, 0, time.UTC)},
+		{order: pgtype.DateOrderMDY, src: "12-17-1997", result: time.Date(1997, 12, 17, 0, 0, 0, 0, time.UTC)},
+		{order: pgtype.DateOrderDMY, src: "17-12-1997", result: time.Date(1997, 12, 17, 0, 0, 0, 0, time.UTC)},
+		{order: pgtype.DateOrderDMY, src: "17.12.1997", result: time.Date(1997, 12, 17, 0, 0, 0, 0, time.UTC)},
+		{order: pgtype.DateOrderMDY, src: "17.12.1997", result: time.Date(1997, 12, 17, 0, 0, 0, 0, time.UTC)},
+		{order: pgtype.DateOrderMDY, src: "03/15/0044 BC", result: time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{order: pgtype.DateOrderDMY, src: "1997-12-17", result: time.Date(1997, 12, 17, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for i, tt := range tests {
+		ci := pgtype.NewConnInfo()
+		ci.SetDateStyle(pgtype.DateStyle{Format: pgtype.DateStyleSQL, Order: tt.order})
+
+		var d pgtype.Date
+		err := d.DecodeText(ci, []byte(tt.src))
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, pgtype.Date{Time: tt.result, Status: pgtype.Present}, d, "%d", i)
+	}
+
+	var d pgtype.Date
+	err := d.DecodeText(nil, []byte("12/17/1997"))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(1997, 12, 17, 0, 0, 0, 0, time.UTC), d.Time)
+
+	for i, src := range []string{"17/12/1997", "12/17", "12/17/1997 07:37:16", "Dec 17 1997"} {
+		err := d.DecodeText(nil, []byte(src))
+		require.Errorf(t, err, "%d", i)
+	}
+}
+
+func TestTimestampDecodeTextDateStyles(t *testing.T) {
+	tests := []struct {
+		order  pgtype.DateOrder
+		src    string
+		result time.Time
+	}{
+		{order: pgtype.DateOrderMDY, src: "12/17/1997 07:37:16", result: time.Date(1997, 12, 17, 7, 37, 16, 0, time.UTC)},
+		{order: pgtype.DateOrderDMY, src: "17/12/1997 07:37:16.123456", result: time.Date(1997, 12, 17, 7, 37, 16, 123456000, time.UTC)},
+		{order: pgtype.DateOrderMDY, src: "Wed Dec 17 07:37:16 1997", result: time.Date(1997, 12, 17, 7, 37, 16, 0, time.UTC)},
+		{order: pgtype.DateOrderDMY, src: "Wed 17 Dec 07:37:16 1997", result: time.Date(1997, 12, 17, 7, 37, 16, 0, time.UTC)},
+		{order: pgtype.DateOrderDMY, src: "17.12.1997 07:37:16.5", result: time.Date(1997, 12, 17, 7, 37, 16, 500000000, time.UTC)},
+		{order: pgtype.DateOrderMDY, src: "Fri Mar 15 12:00:00 0044 BC", result: time.Date(-43, 3, 15, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for i, tt := range tests {
+		ci := pgtype.NewConnInfo()
+		ci.SetDateStyle(pgtype.DateStyle{Format: pgtype.DateStylePostgres, Order: tt.order})
+
+		var ts pgtype.Timestamp
+		err := ts.DecodeText(ci, []byte(tt.src))
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, pgtype.Timestamp{Time: tt.result, Status: pgtype.Present}, ts, "%d", i)
+	}
+
+	var ts pgtype.Timestamp
+	for i, src := range []string{"12/17/1997 07:37:16 PST", "12/17/1997 07:37", "Wed Foo 17 07:37:16 1997", "12/17/1997 25:00:00"} {
+		err := ts.DecodeText(nil, []byte(src))
+		require.Errorf(t, err, "%d", i)
+	}
+}
+
+func TestTimestamptzDecodeTextDateStyles(t *testing.T) {
+	losAngeles, err := time.LoadLocation("America/Los_Angeles")
+	require.NoError(t, err)
+
+	tests := []struct {
+		order    pgtype.DateOrder
+		timeZone *time.Location
+		src      string
+		result   time.Time
+	}{
+		{order: pgtype.DateOrderMDY, src: "12/17/1997 07:37:16 UTC", result: time.Date(1997, 12, 17, 7, 37, 16, 0, time.UTC)},
+		{order: pgtype.DateOrderMDY, src: "12/17/1997 07:37:16 PST", result: time.Date(1997, 12, 17, 15, 37, 16, 0, time.UTC)},
+		{order: pgtype.DateOrderDMY, src: "17/12/1997 07:37:16.25 +05:30", result: time.Date(1997, 12, 17, 2, 7, 16, 250000000, time.UTC)},
+		{order: pgtype.DateOrderMDY, src: "Wed Dec 17 07:37:16 1997 -08", result: time.Date(1997, 12, 17, 15, 37, 16, 0, time.UTC)},
+		{order: pgtype.DateOrderDMY, src: "Wed 17 Dec 07:37:16 1997 GMT", result: time.Date(1997, 12, 17, 7, 37, 16, 0, time.UTC)},
+		{order: pgtype.DateOrderDMY, src: "17.12.1997 07:37:16 CET", result: time.Date(1997, 12, 17, 6, 37, 16, 0, time.UTC)},
+		{order: pgtype.DateOrderMDY, timeZone: losAngeles, src: "07/01/2020 12:00:00 PDT", result: time.Date(2020, 7, 1, 19, 0, 0, 0, time.UTC)},
+		{order: pgtype.DateOrderMDY, timeZone: losAngeles, src: "11/01/2020 01:30:00 PST", result: time.Date(2020, 11, 1, 9, 30, 0, 0, time.UTC)},
+		{order: pgtype.DateOrderMDY, timeZone: losAngeles, src: "11/01/2020 01:30:00 PDT", result: time.Date(2020, 11, 1, 8, 30, 0, 0, time.UTC)},
+		{order: pgtype.DateOrderMDY, timeZone: losAngeles, src: "11/18/1883 12:00:00 LMT", result: time.Date(1883, 11, 18, 19, 52, 58, 0, time.UTC)},
+	}
+
+	for i, tt := range tests {
+		ci := pgtype.NewConnInfo()
+		ci.SetDateStyle(pgtype.DateStyle{Format: pgtype.DateStyleSQL, Order: tt.order})
+		ci.SetTimeZone(tt.timeZone)
+
+		var tstz pgtype.Timestamptz
+		err := tstz.DecodeText(ci, []byte(tt.src))
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, pgtype.Present, tstz.Status, "%d", i)
+		require.Truef(t, tt.result.Equal(tstz.Time), "%d: %v", i, tstz.Time)
+	}
+
+	var tstz pgtype.Timestamptz
+	for i, src := range []string{"12/17/1997 07:37:16", "12/17/1997 07:37:16 XYZ", "12/17/1997 07:37:16 +5"} {
+		err := tstz.DecodeText(nil, []byte(src))
+		require.Errorf(t, err, "%d", i)
+	}
+}
+
+func TestDateStyleDecodeTextServerStyles(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	_, err := conn.Exec(context.Background(), "set timezone = 'America/New_York'")
+	require.NoError(t, err)
+
+	expectedDate := time.Date(1997, 12, 7, 0, 0, 0, 0, time.UTC)
+	expectedTimestamp := time.Date(1997, 12, 7, 7, 37, 16, 123456000, time.UTC)
+	expectedTimestamptz := time.Date(1997, 12, 7, 12, 37, 16, 123456000, time.UTC)
+
+	for _, datestyle := range []string{
+		"ISO, MDY", "ISO, DMY", "SQL, MDY", "SQL, DMY", "SQL, YMD",
+		"Postgres, MDY", "Postgres, DMY", "Postgres, YMD", "German, DMY", "German, MDY",
+	} {
+		_, err := conn.Exec(context.Background(), "set datestyle = '"+datestyle+"'")
+		require.NoError(t, err)
+
+		ci := pgtype.NewConnInfo()
+		require.NoError(t, ci.SetParameterStatus("DateStyle", conn.PgConn().ParameterStatus("DateStyle")))
+		require.NoError(t, ci.SetParameterStatus("TimeZone", conn.PgConn().ParameterStatus("TimeZone")))
+
+		var dateText, timestampText, timestamptzText []byte
+		err = conn.QueryRow(
+			context.Background(),
+			"select '1997-12-07'::date, '1997-12-07 07:37:16.123456'::timestamp, '1997-12-07 07:37:16.123456-05'::timestamptz",
+			pgx.QueryResultFormats{pgx.TextFormatCode, pgx.TextFormatCode, pgx.TextFormatCode},
+		).Scan(&dateText, &timestampText, &timestamptzText)
+		require.NoError(t, err)
+
+		var d pgtype.Date
+		err = d.DecodeText(ci, dateText)
+		require.NoErrorf(t, err, "%s: %s", datestyle, dateText)
+		require.Equalf(t, expectedDate, d.Time, "%s: %s", datestyle, dateText)
+
+		var ts pgtype.Timestamp
+		err = ts.DecodeText(ci, timestampText)
+		require.NoErrorf(t, err, "%s: %s", datestyle, timestampText)
+		require.Equalf(t, expectedTimestamp, ts.Time, "%s: %s", datestyle, timestampText)
+
+		var tstz pgtype.Timestamptz
+		err = tstz.DecodeText(ci, timestamptzText)
+		require.NoErrorf(t, err, "%s: %s", datestyle, timestamptzText)
+		require.Truef(t, expectedTimestamptz.Equal(tstz.Time), "%s: %s", datestyle, timestamptzText)
+	}
+}
@@ -0,0 +1,328 @@
+package numeric
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/jackc/pgtype"
+)
+
+var errUndefined = errors.New("cannot encode status undefined")
+var errBadStatus = errors.New("invalid status")
+
+// Numeric is a PostgreSQL numeric backed by an apd.Decimal. Unlike the shopspring extension it can represent NaN,
+// Infinity and -Infinity, and it keeps the scale of the value so 1.20 round trips as 1.20.
+type Numeric struct {
+	Decimal apd.Decimal
+	Status  pgtype.Status
+}
+
+func (dst *Numeric) Set(src interface{}) error {
+	if src == nil {
+		*dst = Numeric{Status: pgtype.Null}
+		return nil
+	}
+
+	if value, ok := src.(interface{ Get() interface{} }); ok {
+		value2 := value.Get()
+		if value2 != value {
+			return dst.Set(value2)
+		}
+	}
+
+	switch value := src.(type) {
+	case apd.Decimal:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.Set(&value)
+	case *apd.Decimal:
+		if value == nil {
+			*dst = Numeric{Status: pgtype.Null}
+		} else {
+			*dst = Numeric{Status: pgtype.Present}
+			dst.Decimal.Set(value)
+		}
+	case apd.NullDecimal:
+		if value.Valid {
+			*dst = Numeric{Status: pgtype.Present}
+			dst.Decimal.Set(&value.Decimal)
+		} else {
+			*dst = Numeric{Status: pgtype.Null}
+		}
+	case pgtype.Numeric:
+		dst.setNumeric(&value)
+	case float32:
+		return dst.setFloat64(float64(value))
+	case float64:
+		return dst.setFloat64(value)
+	case int8:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetInt64(int64(value))
+	case uint8:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetInt64(int64(value))
+	case int16:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetInt64(int64(value))
+	case uint16:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetInt64(int64(value))
+	case int32:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetInt64(int64(value))
+	case uint32:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetInt64(int64(value))
+	case int64:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetInt64(value)
+	case uint64:
+		// uint64 could be greater than int64 so convert to string then to decimal
+		return dst.DecodeText(nil, []byte(strconv.FormatUint(value, 10)))
+	case int:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetInt64(int64(value))
+	case uint:
+		// uint could be greater than int64 so convert to string then to decimal
+		return dst.DecodeText(nil, []byte(strconv.FormatUint(uint64(value), 10)))
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	default:
+		// If all else fails see if pgtype.Numeric can handle it. If so, translate through that.
+		num := &pgtype.Numeric{}
+		if err := num.Set(value); err != nil {
+			return fmt.Errorf("cannot convert %v to Numeric", value)
+		}
+
+		dst.setNumeric(num)
+	}
+
+	return nil
+}
+
+func (dst *Numeric) setFloat64(f float64) error {
+	*dst = Numeric{Status: pgtype.Present}
+	_, err := dst.Decimal.SetFloat64(f)
+	return err
+}
+
+// setNumeric converts num to an apd.Decimal. The scale of num is preserved.
+func (dst *Numeric) setNumeric(num *pgtype.Numeric) {
+	if num.Status != pgtype.Present {
+		*dst = Numeric{Status: num.Status}
+		return
+	}
+
+	*dst = Numeric{Status: pgtype.Present}
+	switch {
+	case num.NaN:
+		dst.Decimal.Form = apd.NaN
+	case num.InfinityModifier == pgtype.Infinity:
+		dst.Decimal.Form = apd.Infinite
+	case num.InfinityModifier == pgtype.NegativeInfinity:
+		dst.Decimal.Form = apd.Infinite
+		dst.Decimal.Negative = true
+	case num.Int != nil:
+		dst.Decimal.Coeff.SetMathBigInt(num.Int)
+		if num.Int.Sign() < 0 {
+			dst.Decimal.Coeff.Neg(&dst.Decimal.Coeff)
+			dst.Decimal.Negative = true
+		}
+		dst.Decimal.Exponent = num.Exp
+	}
+}
+
+// toNumeric converts src to a pgtype.Numeric. Signaling NaN is converted to NaN as PostgreSQL only has the one.
+func (src *Numeric) toNumeric() *pgtype.Numeric {
+	if src.Status != pgtype.Present {
+		return &pgtype.Numeric{Status: src.Status}
+	}
+
+	switch src.Decimal.Form {
+	case apd.NaN, apd.NaNSignaling:
+		return &pgtype.Numeric{NaN: true, Status: pgtype.Present}
+	case apd.Infinite:
+		if src.Decimal.Negative {
+			return &pgtype.Numeric{InfinityModifier: pgtype.NegativeInfinity, Status: pgtype.Present}
+		}
+		return &pgtype.Numeric{InfinityModifier: pgtype.Infinity, Status: pgtype.Present}
+	}
+
+	n := src.Decimal.Coeff.MathBigInt()
+	if src.Decimal.Negative {
+		n.Neg(n)
+	}
+
+	return &pgtype.Numeric{Int: n, Exp: src.Decimal.Exponent, Status: pgtype.Present}
+}
+
+func (dst Numeric) Get() interface{} {
+	switch dst.Status {
+	case pgtype.Present:
+		return dst.Decimal
+	case pgtype.Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *Numeric) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case pgtype.Present:
+		switch v := dst.(type) {
+		case *apd.Decimal:
+			v.Set(&src.Decimal)
+		case *apd.NullDecimal:
+			v.Valid = true
+			v.Decimal.Set(&src.Decimal)
+		case *string:
+			buf, err := src.EncodeText(nil, nil)
+			if err != nil {
+				return err
+			}
+			*v = string(buf)
+		case *big.Int, *big.Rat, *float32, *float64,
+			*int, *int8, *int16, *int32, *int64, *uint, *uint8, *uint16, *uint32, *uint64:
+			return src.toNumeric().AssignTo(dst)
+		default:
+			if nextDst, retry := pgtype.GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case pgtype.Null:
+		if v, ok := dst.(*apd.NullDecimal); ok {
+			v.Valid = false
+		} else {
+			return pgtype.NullAssignTo(dst)
+		}
+	}
+
+	return nil
+}
+
+func (dst *Numeric) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Numeric{Status: pgtype.Null}
+		return nil
+	}
+
+	var d apd.Decimal
+	if _, _, err := d.SetString(string(src)); err != nil {
+		return err
+	}
+
+	*dst = Numeric{Decimal: d, Status: pgtype.Present}
+	return nil
+}
+
+func (dst *Numeric) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Numeric{Status: pgtype.Null}
+		return nil
+	}
+
+	num := &pgtype.Numeric{}
+	if err := num.DecodeBinary(ci, src); err != nil {
+		return err
+	}
+
+	dst.setNumeric(num)
+	return nil
+}
+
+func (src Numeric) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+
+	switch src.Decimal.Form {
+	case apd.NaN, apd.NaNSignaling:
+		return append(buf, "NaN"...), nil
+	case apd.Infinite:
+		if src.Decimal.Negative {
+			return append(buf, "-Infinity"...), nil
+		}
+		return append(buf, "Infinity"...), nil
+	}
+
+	// 'f' never uses exponent notation and keeps trailing zeros so the scale is preserved.
+	return src.Decimal.Append(buf, 'f'), nil
+}
+
+func (src Numeric) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+
+	return src.toNumeric().EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Numeric) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Numeric{Status: pgtype.Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case float64:
+		return dst.setFloat64(src)
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		return dst.DecodeText(nil, src)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Numeric) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
+
+// MarshalJSON encodes src as a JSON string in the same manner as the shopspring extension. NaN and the infinities are
+// encoded as "NaN", "Infinity" and "-Infinity".
+func (src Numeric) MarshalJSON() ([]byte, error) {
+	switch src.Status {
+	case pgtype.Present:
+		buf := []byte{'"'}
+		buf, err := src.EncodeText(nil, buf)
+		if err != nil {
+			return nil, err
+		}
+		return append(buf, '"'), nil
+	case pgtype.Null:
+		return []byte("null"), nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+
+	return nil, errBadStatus
+}
+
+// UnmarshalJSON accepts a JSON string or number.
+func (dst *Numeric) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*dst = Numeric{Status: pgtype.Null}
+		return nil
+	}
+
+	if len(b) >= 2 && b[0] == '"' && b[len(b)-1] == '"' {
+		b = b[1 : len(b)-1]
+	}
+
+	return dst.DecodeText(nil, b)
+}
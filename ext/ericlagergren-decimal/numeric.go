@@ -0,0 +1,303 @@
+package numeric
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/jackc/pgtype"
+)
+
+var errUndefined = errors.New("cannot encode status undefined")
+var errBadStatus = errors.New("invalid status")
+
+// Numeric is a PostgreSQL numeric backed by a decimal.Big. It can represent NaN, Infinity and -Infinity, and it keeps
+// the scale of the value so 1.20 round trips as 1.20.
+type Numeric struct {
+	Decimal decimal.Big
+	Status  pgtype.Status
+}
+
+func (dst *Numeric) Set(src interface{}) error {
+	if src == nil {
+		*dst = Numeric{Status: pgtype.Null}
+		return nil
+	}
+
+	if value, ok := src.(interface{ Get() interface{} }); ok {
+		value2 := value.Get()
+		if value2 != value {
+			return dst.Set(value2)
+		}
+	}
+
+	switch value := src.(type) {
+	case decimal.Big:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.Copy(&value)
+	case *decimal.Big:
+		if value == nil {
+			*dst = Numeric{Status: pgtype.Null}
+		} else {
+			*dst = Numeric{Status: pgtype.Present}
+			dst.Decimal.Copy(value)
+		}
+	case pgtype.Numeric:
+		dst.setNumeric(&value)
+	case float32:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetFloat64(float64(value))
+	case float64:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetFloat64(value)
+	case int8:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetMantScale(int64(value), 0)
+	case uint8:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetUint64(uint64(value))
+	case int16:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetMantScale(int64(value), 0)
+	case uint16:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetUint64(uint64(value))
+	case int32:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetMantScale(int64(value), 0)
+	case uint32:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetUint64(uint64(value))
+	case int64:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetMantScale(value, 0)
+	case uint64:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetUint64(value)
+	case int:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetMantScale(int64(value), 0)
+	case uint:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetUint64(uint64(value))
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	default:
+		// If all else fails see if pgtype.Numeric can handle it. If so, translate through that.
+		num := &pgtype.Numeric{}
+		if err := num.Set(value); err != nil {
+			return fmt.Errorf("cannot convert %v to Numeric", value)
+		}
+
+		dst.setNumeric(num)
+	}
+
+	return nil
+}
+
+// setNumeric converts num to a decimal.Big. The scale of num is preserved.
+func (dst *Numeric) setNumeric(num *pgtype.Numeric) {
+	if num.Status != pgtype.Present {
+		*dst = Numeric{Status: num.Status}
+		return
+	}
+
+	*dst = Numeric{Status: pgtype.Present}
+	switch {
+	case num.NaN:
+		dst.Decimal.SetNaN(false)
+	case num.InfinityModifier == pgtype.Infinity:
+		dst.Decimal.SetInf(false)
+	case num.InfinityModifier == pgtype.NegativeInfinity:
+		dst.Decimal.SetInf(true)
+	case num.Int != nil:
+		dst.Decimal.SetBigMantScale(num.Int, -int(num.Exp))
+	}
+}
+
+// toNumeric converts src to a pgtype.Numeric. Signaling NaN is converted to NaN as PostgreSQL only has the one.
+func (src *Numeric) toNumeric() *pgtype.Numeric {
+	if src.Status != pgtype.Present {
+		return &pgtype.Numeric{Status: src.Status}
+	}
+
+	switch {
+	case src.Decimal.IsNaN(0):
+		return &pgtype.Numeric{NaN: true, Status: pgtype.Present}
+	case src.Decimal.IsInf(-1):
+		return &pgtype.Numeric{InfinityModifier: pgtype.NegativeInfinity, Status: pgtype.Present}
+	case src.Decimal.IsInf(+1):
+		return &pgtype.Numeric{InfinityModifier: pgtype.Infinity, Status: pgtype.Present}
+	}
+
+	// Setting the scale to 0 leaves the unscaled value which can then be extracted as an integer.
+	var unscaled decimal.Big
+	unscaled.Copy(&src.Decimal).SetScale(0)
+
+	return &pgtype.Numeric{Int: unscaled.Int(nil), Exp: int32(-src.Decimal.Scale()), Status: pgtype.Present}
+}
+
+// Get returns a copy of the underlying decimal.Big so it may be modified without affecting dst.
+func (dst Numeric) Get() interface{} {
+	switch dst.Status {
+	case pgtype.Present:
+		return new(decimal.Big).Copy(&dst.Decimal)
+	case pgtype.Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *Numeric) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case pgtype.Present:
+		switch v := dst.(type) {
+		case *decimal.Big:
+			v.Copy(&src.Decimal)
+		case *string:
+			buf, err := src.EncodeText(nil, nil)
+			if err != nil {
+				return err
+			}
+			*v = string(buf)
+		case *big.Int, *big.Rat, *float32, *float64,
+			*int, *int8, *int16, *int32, *int64, *uint, *uint8, *uint16, *uint32, *uint64:
+			return src.toNumeric().AssignTo(dst)
+		default:
+			if nextDst, retry := pgtype.GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case pgtype.Null:
+		return pgtype.NullAssignTo(dst)
+	}
+
+	return nil
+}
+
+func (dst *Numeric) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Numeric{Status: pgtype.Null}
+		return nil
+	}
+
+	var d decimal.Big
+	if _, ok := d.SetString(string(src)); !ok {
+		return fmt.Errorf("invalid numeric: %q", src)
+	}
+
+	*dst = Numeric{Decimal: d, Status: pgtype.Present}
+	return nil
+}
+
+func (dst *Numeric) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Numeric{Status: pgtype.Null}
+		return nil
+	}
+
+	num := &pgtype.Numeric{}
+	if err := num.DecodeBinary(ci, src); err != nil {
+		return err
+	}
+
+	dst.setNumeric(num)
+	return nil
+}
+
+func (src Numeric) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+
+	switch {
+	case src.Decimal.IsNaN(0):
+		return append(buf, "NaN"...), nil
+	case src.Decimal.IsInf(-1):
+		return append(buf, "-Infinity"...), nil
+	case src.Decimal.IsInf(+1):
+		return append(buf, "Infinity"...), nil
+	}
+
+	// %f never uses exponent notation and keeps trailing zeros so the scale is preserved.
+	return append(buf, fmt.Sprintf("%f", &src.Decimal)...), nil
+}
+
+func (src Numeric) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+
+	return src.toNumeric().EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Numeric) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Numeric{Status: pgtype.Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case float64:
+		*dst = Numeric{Status: pgtype.Present}
+		dst.Decimal.SetFloat64(src)
+		return nil
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		return dst.DecodeText(nil, src)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Numeric) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
+
+// MarshalJSON encodes src as a JSON string in the same manner as the shopspring extension. NaN and the infinities are
+// encoded as "NaN", "Infinity" and "-Infinity".
+func (src Numeric) MarshalJSON() ([]byte, error) {
+	switch src.Status {
+	case pgtype.Present:
+		buf := []byte{'"'}
+		buf, err := src.EncodeText(nil, buf)
+		if err != nil {
+			return nil, err
+		}
+		return append(buf, '"'), nil
+	case pgtype.Null:
+		return []byte("null"), nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+
+	return nil, errBadStatus
+}
+
+// UnmarshalJSON accepts a JSON string or number.
+func (dst *Numeric) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*dst = Numeric{Status: pgtype.Null}
+		return nil
+	}
+
+	if len(b) >= 2 && b[0] == '"' && b[len(b)-1] == '"' {
+		b = b[1 : len(b)-1]
+	}
+
+	return dst.DecodeText(nil, b)
+}
@@ -0,0 +1,96 @@
+package numeric_test
+
+import (
+	"testing"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/jackc/pgtype"
+	decimalnumeric "github.com/jackc/pgtype/ext/ericlagergren-decimal"
+	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumericArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscodeEqFunc(t, "numeric[]", []interface{}{
+		&decimalnumeric.NumericArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&decimalnumeric.NumericArray{
+			Elements: []decimalnumeric.Numeric{
+				{Decimal: mustParseDecimal(t, "1.20"), Status: pgtype.Present},
+				{Decimal: mustParseDecimal(t, "NaN"), Status: pgtype.Present},
+				{Decimal: mustParseDecimal(t, "-Infinity"), Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 4, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&decimalnumeric.NumericArray{Status: pgtype.Null},
+	}, func(aa, bb interface{}) bool {
+		a := aa.(decimalnumeric.NumericArray)
+		b := bb.(decimalnumeric.NumericArray)
+
+		abuf, _ := a.EncodeText(nil, nil)
+		bbuf, _ := b.EncodeText(nil, nil)
+		return a.Status == b.Status && string(abuf) == string(bbuf)
+	})
+}
+
+func TestNumericArraySetAndAssignTo(t *testing.T) {
+	var a decimalnumeric.NumericArray
+
+	err := a.Set([]decimal.Big{mustParseDecimal(t, "1.50"), mustParseDecimal(t, "Infinity")})
+	require.NoError(t, err)
+	buf, err := a.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "{1.50,Infinity}", string(buf))
+
+	var decimals []decimal.Big
+	err = a.AssignTo(&decimals)
+	require.NoError(t, err)
+	require.Len(t, decimals, 2)
+	require.Equal(t, "1.50", decimals[0].String())
+	require.True(t, decimals[1].IsInf(+1))
+
+	var strs []string
+	err = a.AssignTo(&strs)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1.50", "Infinity"}, strs)
+
+	err = a.Set([]*decimal.Big{new(decimal.Big).SetMantScale(2, 0), nil})
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Null, a.Elements[1].Status)
+
+	var ptrs []*decimal.Big
+	err = a.AssignTo(&ptrs)
+	require.NoError(t, err)
+	require.Len(t, ptrs, 2)
+	require.Equal(t, "2", ptrs[0].String())
+	require.Nil(t, ptrs[1])
+
+	err = a.Set([][]string{{"1", "2"}, {"3", "4.0"}})
+	require.NoError(t, err)
+	buf, err = a.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "{{1,2},{3,4.0}}", string(buf))
+
+	var matrix [][]float64
+	err = a.AssignTo(&matrix)
+	require.NoError(t, err)
+	require.Equal(t, [][]float64{{1, 2}, {3, 4}}, matrix)
+
+	var text decimalnumeric.NumericArray
+	err = text.DecodeText(nil, []byte("{NaN,-0.010,NULL}"))
+	require.NoError(t, err)
+	buf, err = text.EncodeBinary(pgtype.NewConnInfo(), nil)
+	require.NoError(t, err)
+
+	var binary decimalnumeric.NumericArray
+	err = binary.DecodeBinary(nil, buf)
+	require.NoError(t, err)
+	buf, err = binary.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "{NaN,-0.010,NULL}", string(buf))
+}
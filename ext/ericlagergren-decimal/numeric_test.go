@@ -0,0 +1,219 @@
+package numeric_test
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/jackc/pgtype"
+	decimalnumeric "github.com/jackc/pgtype/ext/ericlagergren-decimal"
+	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParseDecimal(t *testing.T, src string) decimal.Big {
+	var dec decimal.Big
+	if _, ok := dec.SetString(src); !ok {
+		t.Fatalf("invalid decimal: %s", src)
+	}
+	return dec
+}
+
+func numericEqual(aa, bb interface{}) bool {
+	a := aa.(decimalnumeric.Numeric)
+	b := bb.(decimalnumeric.Numeric)
+
+	abuf, _ := a.EncodeText(nil, nil)
+	bbuf, _ := b.EncodeText(nil, nil)
+	return a.Status == b.Status && string(abuf) == string(bbuf)
+}
+
+func TestNumericTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscodeEqFunc(t, "numeric", []interface{}{
+		&decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "0"), Status: pgtype.Present},
+		&decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "1"), Status: pgtype.Present},
+		&decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "-1"), Status: pgtype.Present},
+		&decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "100000"), Status: pgtype.Present},
+		&decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "1.20"), Status: pgtype.Present},
+		&decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "0.000001"), Status: pgtype.Present},
+		&decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "-3.14000"), Status: pgtype.Present},
+		&decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "4309132809320932980457137401234890237489238912983572189348951289375283573984571892758234678903467889512893489128589347891272139.8489235871258912789347891235879148795891238915678189467128957812395781238579189025891238901583915890128973578957912385798125789012378905238905471598123758923478294374327894237892234"), Status: pgtype.Present},
+		&decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "NaN"), Status: pgtype.Present},
+		&decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "Infinity"), Status: pgtype.Present},
+		&decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "-Infinity"), Status: pgtype.Present},
+		&decimalnumeric.Numeric{Status: pgtype.Null},
+	}, numericEqual)
+}
+
+func TestNumericCodecs(t *testing.T) {
+	tests := []string{
+		"0",
+		"0.00",
+		"1",
+		"-1",
+		"1.20",
+		"1000",
+		"-0.000000001",
+		"12345678901234567890123.456",
+		"4309132809320932980457137401234890237489238912983572189348951289375283573984571892758234678903467889512893489128589347891272139.8489235871258912789347891235879148795891238915678189467128957812395781238579189025891238901583915890128973578957912385798125789012378905238905471598123758923478294374327894237892234",
+		"NaN",
+		"Infinity",
+		"-Infinity",
+	}
+
+	for i, s := range tests {
+		var n decimalnumeric.Numeric
+		err := n.DecodeText(nil, []byte(s))
+		require.NoErrorf(t, err, "%d", i)
+
+		buf, err := n.EncodeText(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, s, string(buf), "%d", i)
+
+		buf, err = n.EncodeBinary(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+
+		var pgNum pgtype.Numeric
+		err = pgNum.DecodeBinary(nil, buf)
+		require.NoErrorf(t, err, "%d", i)
+
+		var dst decimalnumeric.Numeric
+		err = dst.DecodeBinary(nil, buf)
+		require.NoErrorf(t, err, "%d", i)
+		require.Truef(t, numericEqual(n, dst), "%d: %v", i, dst)
+	}
+
+	var n decimalnumeric.Numeric
+	err := n.DecodeText(nil, []byte("1E+3"))
+	require.NoError(t, err)
+	buf, err := n.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "1000", string(buf))
+
+	err = n.DecodeText(nil, []byte("abc"))
+	require.Error(t, err)
+}
+
+func TestNumericSet(t *testing.T) {
+	successfulTests := []struct {
+		source interface{}
+		result string
+	}{
+		{source: mustParseDecimal(t, "1.20"), result: "1.20"},
+		{source: new(decimal.Big).SetMantScale(-75, 1), result: "-7.5"},
+		{source: float32(1), result: "1"},
+		{source: float64(-1.5), result: "-1.5"},
+		{source: math.NaN(), result: "NaN"},
+		{source: math.Inf(-1), result: "-Infinity"},
+		{source: int8(1), result: "1"},
+		{source: int16(1), result: "1"},
+		{source: int32(1), result: "1"},
+		{source: int64(-1), result: "-1"},
+		{source: uint64(math.MaxUint64), result: "18446744073709551615"},
+		{source: "0.0100", result: "0.0100"},
+		{source: 42, result: "42"},
+		{source: &pgtype.Numeric{Int: big.NewInt(-314), Exp: -2, Status: pgtype.Present}, result: "-3.14"},
+		{source: &pgtype.Numeric{NaN: true, Status: pgtype.Present}, result: "NaN"},
+	}
+
+	for i, tt := range successfulTests {
+		var r decimalnumeric.Numeric
+		err := r.Set(tt.source)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, pgtype.Present, r.Status, "%d", i)
+
+		buf, err := r.EncodeText(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, string(buf), "%d", i)
+	}
+
+	nullTests := []interface{}{nil, (*decimal.Big)(nil)}
+	for i, src := range nullTests {
+		var r decimalnumeric.Numeric
+		err := r.Set(src)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, pgtype.Null, r.Status, "%d", i)
+	}
+}
+
+func TestNumericAssignTo(t *testing.T) {
+	n := decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "42.50"), Status: pgtype.Present}
+
+	var d decimal.Big
+	err := n.AssignTo(&d)
+	require.NoError(t, err)
+	require.Equal(t, "42.50", d.String())
+
+	var s string
+	err = n.AssignTo(&s)
+	require.NoError(t, err)
+	require.Equal(t, "42.50", s)
+
+	var f float64
+	err = n.AssignTo(&f)
+	require.NoError(t, err)
+	require.Equal(t, 42.5, f)
+
+	var r big.Rat
+	err = n.AssignTo(&r)
+	require.NoError(t, err)
+	require.Equal(t, big.NewRat(85, 2), &r)
+
+	var i int64
+	err = n.AssignTo(&i)
+	require.Error(t, err)
+
+	n = decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "4200E-2"), Status: pgtype.Present}
+	err = n.AssignTo(&i)
+	require.NoError(t, err)
+	require.EqualValues(t, 42, i)
+
+	var pi *int64
+	err = n.AssignTo(&pi)
+	require.NoError(t, err)
+	require.EqualValues(t, 42, *pi)
+
+	n = decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "NaN"), Status: pgtype.Present}
+	err = n.AssignTo(&f)
+	require.NoError(t, err)
+	require.True(t, math.IsNaN(f))
+
+	n = decimalnumeric.Numeric{Status: pgtype.Null}
+	pi = &i
+	err = n.AssignTo(&pi)
+	require.NoError(t, err)
+	require.Nil(t, pi)
+
+	err = n.AssignTo(&d)
+	require.Error(t, err)
+}
+
+func TestNumericMarshalJSON(t *testing.T) {
+	tests := []struct {
+		source decimalnumeric.Numeric
+		result string
+	}{
+		{source: decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "1.20"), Status: pgtype.Present}, result: `"1.20"`},
+		{source: decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "NaN"), Status: pgtype.Present}, result: `"NaN"`},
+		{source: decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "-Infinity"), Status: pgtype.Present}, result: `"-Infinity"`},
+		{source: decimalnumeric.Numeric{Status: pgtype.Null}, result: `null`},
+	}
+
+	for i, tt := range tests {
+		buf, err := json.Marshal(tt.source)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, string(buf), "%d", i)
+
+		var dst decimalnumeric.Numeric
+		err = json.Unmarshal(buf, &dst)
+		require.NoErrorf(t, err, "%d", i)
+		require.Truef(t, numericEqual(tt.source, dst), "%d", i)
+	}
+
+	var dst decimalnumeric.Numeric
+	err := json.Unmarshal([]byte("12.5"), &dst)
+	require.NoError(t, err)
+	require.True(t, numericEqual(decimalnumeric.Numeric{Decimal: mustParseDecimal(t, "12.5"), Status: pgtype.Present}, dst))
+}
@@ -0,0 +1,93 @@
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgtype"
+	guuid "github.com/jackc/pgtype/ext/google-uuid"
+	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUUIDArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "uuid[]", []interface{}{
+		&guuid.UUIDArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&guuid.UUIDArray{
+			Elements: []guuid.UUID{
+				{UUID: uuid.UUID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&guuid.UUIDArray{Status: pgtype.Null},
+	})
+}
+
+func TestUUIDArraySetAndAssignTo(t *testing.T) {
+	u1 := uuid.UUID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	u2 := uuid.UUID{16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31}
+
+	expected := guuid.UUIDArray{
+		Elements: []guuid.UUID{
+			{UUID: u1, Status: pgtype.Present},
+			{UUID: u2, Status: pgtype.Present},
+		},
+		Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+		Status:     pgtype.Present,
+	}
+
+	var r guuid.UUIDArray
+	err := r.Set([]uuid.UUID{u1, u2})
+	require.NoError(t, err)
+	require.Equal(t, expected, r)
+
+	var uuids []uuid.UUID
+	err = r.AssignTo(&uuids)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{u1, u2}, uuids)
+
+	err = r.Set([]uuid.NullUUID{{UUID: u1, Valid: true}, {}})
+	require.NoError(t, err)
+	require.Equal(t, guuid.UUIDArray{
+		Elements: []guuid.UUID{
+			{UUID: u1, Status: pgtype.Present},
+			{Status: pgtype.Null},
+		},
+		Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+		Status:     pgtype.Present,
+	}, r)
+
+	var nullUUIDs []uuid.NullUUID
+	err = r.AssignTo(&nullUUIDs)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.NullUUID{{UUID: u1, Valid: true}, {}}, nullUUIDs)
+
+	var ptrs []*uuid.UUID
+	err = r.AssignTo(&ptrs)
+	require.NoError(t, err)
+	require.Len(t, ptrs, 2)
+	require.Equal(t, u1, *ptrs[0])
+	require.Nil(t, ptrs[1])
+
+	err = r.AssignTo(&uuids)
+	require.Error(t, err)
+
+	err = r.Set([][]uuid.UUID{{u1}, {u2}})
+	require.NoError(t, err)
+	require.Equal(t, []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}, {Length: 1, LowerBound: 1}}, r.Dimensions)
+
+	var matrix [][]uuid.UUID
+	err = r.AssignTo(&matrix)
+	require.NoError(t, err)
+	require.Equal(t, [][]uuid.UUID{{u1}, {u2}}, matrix)
+
+	err = r.Set([]uuid.UUID(nil))
+	require.NoError(t, err)
+	require.Equal(t, guuid.UUIDArray{Status: pgtype.Null}, r)
+}
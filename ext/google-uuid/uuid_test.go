@@ -0,0 +1,199 @@
+package uuid_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgtype"
+	guuid "github.com/jackc/pgtype/ext/google-uuid"
+	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUUIDTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "uuid", []interface{}{
+		&guuid.UUID{UUID: [16]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, Status: pgtype.Present},
+		&guuid.UUID{Status: pgtype.Null},
+	})
+}
+
+func TestUUIDSet(t *testing.T) {
+	u := uuid.UUID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+	successfulTests := []struct {
+		source interface{}
+		result guuid.UUID
+	}{
+		{
+			source: &guuid.UUID{UUID: u, Status: pgtype.Present},
+			result: guuid.UUID{UUID: u, Status: pgtype.Present},
+		},
+		{
+			source: u,
+			result: guuid.UUID{UUID: u, Status: pgtype.Present},
+		},
+		{
+			source: &u,
+			result: guuid.UUID{UUID: u, Status: pgtype.Present},
+		},
+		{
+			source: (*uuid.UUID)(nil),
+			result: guuid.UUID{Status: pgtype.Null},
+		},
+		{
+			source: uuid.NullUUID{UUID: u, Valid: true},
+			result: guuid.UUID{UUID: u, Status: pgtype.Present},
+		},
+		{
+			source: uuid.NullUUID{},
+			result: guuid.UUID{Status: pgtype.Null},
+		},
+		{
+			source: [16]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+			result: guuid.UUID{UUID: u, Status: pgtype.Present},
+		},
+		{
+			source: []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+			result: guuid.UUID{UUID: u, Status: pgtype.Present},
+		},
+		{
+			source: "00010203-0405-0607-0809-0a0b0c0d0e0f",
+			result: guuid.UUID{UUID: u, Status: pgtype.Present},
+		},
+		{
+			source: nil,
+			result: guuid.UUID{Status: pgtype.Null},
+		},
+	}
+
+	for i, tt := range successfulTests {
+		var r guuid.UUID
+		err := r.Set(tt.source)
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+		}
+
+		if r != tt.result {
+			t.Errorf("%d: expected %v to convert to %v, but it was %v", i, tt.source, tt.result, r)
+		}
+	}
+
+	var r guuid.UUID
+	err := r.Set("not a uuid")
+	require.Error(t, err)
+}
+
+func TestUUIDAssignTo(t *testing.T) {
+	u := uuid.UUID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	src := guuid.UUID{UUID: u, Status: pgtype.Present}
+
+	{
+		var dst uuid.UUID
+		err := src.AssignTo(&dst)
+		require.NoError(t, err)
+		require.Equal(t, u, dst)
+	}
+
+	{
+		var dst uuid.NullUUID
+		err := src.AssignTo(&dst)
+		require.NoError(t, err)
+		require.Equal(t, uuid.NullUUID{UUID: u, Valid: true}, dst)
+	}
+
+	{
+		var dst *uuid.UUID
+		err := src.AssignTo(&dst)
+		require.NoError(t, err)
+		require.Equal(t, u, *dst)
+	}
+
+	{
+		var dst [16]byte
+		err := src.AssignTo(&dst)
+		require.NoError(t, err)
+		require.Equal(t, [16]byte(u), dst)
+	}
+
+	{
+		var dst []byte
+		err := src.AssignTo(&dst)
+		require.NoError(t, err)
+		if !bytes.Equal(dst, u[:]) {
+			t.Errorf("expected %v to assign %v, but result was %v", src, u[:], dst)
+		}
+	}
+
+	{
+		var dst string
+		err := src.AssignTo(&dst)
+		require.NoError(t, err)
+		require.Equal(t, "00010203-0405-0607-0809-0a0b0c0d0e0f", dst)
+	}
+
+	null := guuid.UUID{Status: pgtype.Null}
+
+	{
+		dst := uuid.NullUUID{UUID: u, Valid: true}
+		err := null.AssignTo(&dst)
+		require.NoError(t, err)
+		require.Equal(t, uuid.NullUUID{}, dst)
+	}
+
+	{
+		dst := &u
+		err := null.AssignTo(&dst)
+		require.NoError(t, err)
+		require.Nil(t, dst)
+	}
+
+	{
+		var dst uuid.UUID
+		err := null.AssignTo(&dst)
+		require.Error(t, err)
+	}
+}
+
+func TestUUIDMarshalJSON(t *testing.T) {
+	u := guuid.UUID{UUID: uuid.UUID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, Status: pgtype.Present}
+
+	buf, err := json.Marshal(u)
+	require.NoError(t, err)
+	require.Equal(t, `"00010203-0405-0607-0809-0a0b0c0d0e0f"`, string(buf))
+
+	var dst guuid.UUID
+	err = json.Unmarshal(buf, &dst)
+	require.NoError(t, err)
+	require.Equal(t, u, dst)
+
+	buf, err = json.Marshal(guuid.UUID{Status: pgtype.Null})
+	require.NoError(t, err)
+	require.Equal(t, "null", string(buf))
+
+	err = json.Unmarshal(buf, &dst)
+	require.NoError(t, err)
+	require.Equal(t, guuid.UUID{Status: pgtype.Null}, dst)
+}
+
+func TestRegister(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	guuid.Register(ci)
+
+	dt, ok := ci.DataTypeForOID(pgtype.UUIDOID)
+	require.True(t, ok)
+	require.IsType(t, &guuid.UUID{}, dt.Value)
+
+	dt, ok = ci.DataTypeForOID(pgtype.UUIDArrayOID)
+	require.True(t, ok)
+	require.IsType(t, &guuid.UUIDArray{}, dt.Value)
+
+	dt, ok = ci.DataTypeForValue(uuid.UUID{})
+	require.True(t, ok)
+	require.Equal(t, "uuid", dt.Name)
+
+	dt, ok = ci.DataTypeForValue([]uuid.UUID{})
+	require.True(t, ok)
+	require.Equal(t, "_uuid", dt.Name)
+}
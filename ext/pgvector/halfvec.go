@@ -0,0 +1,236 @@
+package pgvector
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/jackc/pgio"
+	"github.com/jackc/pgtype"
+)
+
+// HalfVector is the pgvector halfvec type. The elements are stored as float32 and converted to and from half precision
+// floats when encoding and decoding. Encoding fails if a finite element is too large for a half precision float.
+type HalfVector struct {
+	Vec    []float32
+	Status pgtype.Status
+}
+
+// Set converts src to dst. src may be nil, a []float32, []float64, Vector, HalfVector, SparseVector or a string in the
+// halfvec text format.
+func (dst *HalfVector) Set(src interface{}) error {
+	if value, ok := src.(HalfVector); ok {
+		*dst = value
+		return nil
+	}
+	if value, ok := src.(string); ok {
+		return dst.DecodeText(nil, []byte(value))
+	}
+
+	var v Vector
+	err := v.Set(src)
+	if err != nil {
+		return fmt.Errorf("cannot convert %v to HalfVector", src)
+	}
+	*dst = HalfVector(v)
+	return nil
+}
+
+func (dst HalfVector) Get() interface{} {
+	switch dst.Status {
+	case pgtype.Present:
+		return dst.Vec
+	case pgtype.Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *HalfVector) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case pgtype.Present:
+		return assignFloat32s(src.Vec, dst, src.AssignTo)
+	case pgtype.Null:
+		return pgtype.NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot assign %v into %T", src, dst)
+}
+
+// DecodeText decodes the halfvec text format, e.g. [1,2,3]. Elements are rounded to half precision.
+func (dst *HalfVector) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = HalfVector{Status: pgtype.Null}
+		return nil
+	}
+
+	vec, err := parseVectorText(src)
+	if err != nil {
+		return err
+	}
+	for i, f := range vec {
+		h, err := float32ToHalf(f)
+		if err != nil {
+			return err
+		}
+		vec[i] = halfToFloat32(h)
+	}
+
+	*dst = HalfVector{Vec: vec, Status: pgtype.Present}
+	return nil
+}
+
+// DecodeBinary decodes the halfvec binary format: the int16 number of dimensions, an unused int16 and the half
+// precision elements.
+func (dst *HalfVector) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = HalfVector{Status: pgtype.Null}
+		return nil
+	}
+
+	if len(src) < 4 {
+		return fmt.Errorf("invalid length for halfvec: %v", len(src))
+	}
+	dim := int(int16(binary.BigEndian.Uint16(src)))
+	src = src[4:]
+	if len(src) != dim*2 {
+		return fmt.Errorf("invalid length for halfvec with %d dimensions: %v", dim, len(src))
+	}
+
+	vec := make([]float32, dim)
+	for i := range vec {
+		vec[i] = halfToFloat32(binary.BigEndian.Uint16(src[i*2:]))
+	}
+
+	*dst = HalfVector{Vec: vec, Status: pgtype.Present}
+	return nil
+}
+
+func (src HalfVector) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+
+	vec := make([]float32, len(src.Vec))
+	for i, f := range src.Vec {
+		h, err := float32ToHalf(f)
+		if err != nil {
+			return nil, err
+		}
+		vec[i] = halfToFloat32(h)
+	}
+
+	return appendVectorText(buf, vec), nil
+}
+
+func (src HalfVector) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+
+	if len(src.Vec) > math.MaxInt16 {
+		return nil, fmt.Errorf("halfvec cannot have more than %d dimensions", math.MaxInt16)
+	}
+
+	buf = pgio.AppendInt16(buf, int16(len(src.Vec)))
+	buf = pgio.AppendInt16(buf, 0)
+	for _, f := range src.Vec {
+		h, err := float32ToHalf(f)
+		if err != nil {
+			return nil, err
+		}
+		buf = pgio.AppendUint16(buf, h)
+	}
+	return buf, nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *HalfVector) Scan(src interface{}) error {
+	if src == nil {
+		*dst = HalfVector{Status: pgtype.Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		return dst.DecodeText(nil, src)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src HalfVector) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
+
+// float32ToHalf converts f to an IEEE 754 half precision float rounding to nearest even. Finite values too large for a
+// half precision float are an error.
+func float32ToHalf(f float32) (uint16, error) {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	if exp == 0xff {
+		if mant != 0 {
+			return sign | 0x7e00, nil // NaN
+		}
+		return sign | 0x7c00, nil // Infinity
+	}
+
+	// e is the biased half precision exponent.
+	e := exp - 127 + 15
+	if e <= 0 {
+		// Subnormal or zero. Values below half the smallest subnormal round to zero.
+		if e < -10 {
+			return sign, nil
+		}
+		mant |= 0x800000
+		shift := uint(14 - e)
+		h := mant >> shift
+		rem, halfway := mant&(1<<shift-1), uint32(1)<<(shift-1)
+		if rem > halfway || rem == halfway && h&1 == 1 {
+			h++
+		}
+		return sign | uint16(h), nil
+	}
+
+	h := uint32(e)<<10 | mant>>13
+	rem := mant & 0x1fff
+	if rem > 0x1000 || rem == 0x1000 && h&1 == 1 {
+		h++ // May carry into the exponent, which is correct.
+	}
+	if h >= 0x7c00 {
+		return 0, fmt.Errorf("%v is out of range for halfvec", f)
+	}
+	return sign | uint16(h), nil
+}
+
+// halfToFloat32 converts an IEEE 754 half precision float to float32, which is exact.
+func halfToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0:
+		// Zero or subnormal: mant * 2^-24.
+		f := float32(mant) / (1 << 24)
+		return math.Float32frombits(math.Float32bits(f) | sign)
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	default:
+		return math.Float32frombits(sign | (exp-15+127)<<23 | mant<<13)
+	}
+}
@@ -0,0 +1,94 @@
+package pgvector_test
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/ext/pgvector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHalfVectorCodec(t *testing.T) {
+	tests := []struct {
+		value   float32
+		half    string
+		decoded float32
+	}{
+		{value: 1, half: "3c00", decoded: 1},
+		{value: -2, half: "c000", decoded: -2},
+		{value: 0, half: "0000", decoded: 0},
+		{value: float32(math.Copysign(0, -1)), half: "8000", decoded: 0},
+		{value: 65504, half: "7bff", decoded: 65504},
+		{value: 65519, half: "7bff", decoded: 65504},
+		{value: 0.1, half: "2e66", decoded: 0.099975586},
+		{value: 1.0009766, half: "3c01", decoded: 1.0009766},
+		{value: 1.00048828125, half: "3c00", decoded: 1},             // Halfway rounds to even.
+		{value: 1.00146484375, half: "3c02", decoded: 1.001953125},   // Halfway rounds to even.
+		{value: 6.1035156e-05, half: "0400", decoded: 6.1035156e-05}, // Smallest normal.
+		{value: 5.9604645e-08, half: "0001", decoded: 5.9604645e-08}, // Smallest subnormal.
+		{value: 2.9802322e-08, half: "0000", decoded: 0},             // Half the smallest subnormal rounds to even.
+		{value: 3.0e-08, half: "0001", decoded: 5.9604645e-08},
+		{value: 1e-10, half: "0000", decoded: 0},
+		{value: float32(math.Inf(1)), half: "7c00", decoded: float32(math.Inf(1))},
+		{value: float32(math.Inf(-1)), half: "fc00", decoded: float32(math.Inf(-1))},
+	}
+
+	for i, tt := range tests {
+		v := pgvector.HalfVector{Vec: []float32{tt.value}, Status: pgtype.Present}
+		buf, err := v.EncodeBinary(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+		assert.Equalf(t, "00010000"+tt.half, hex.EncodeToString(buf), "%d: %v", i, tt.value)
+
+		var r pgvector.HalfVector
+		require.NoErrorf(t, r.DecodeBinary(nil, buf), "%d", i)
+		assert.Equalf(t, tt.decoded, r.Vec[0], "%d", i)
+	}
+
+	buf, err := pgvector.HalfVector{Vec: []float32{float32(math.NaN())}, Status: pgtype.Present}.EncodeBinary(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "000100007e00", hex.EncodeToString(buf))
+
+	_, err = pgvector.HalfVector{Vec: []float32{65520}, Status: pgtype.Present}.EncodeBinary(nil, nil)
+	require.Error(t, err)
+	_, err = pgvector.HalfVector{Vec: []float32{-1e10}, Status: pgtype.Present}.EncodeText(nil, nil)
+	require.Error(t, err)
+
+	var r pgvector.HalfVector
+	require.NoError(t, r.DecodeBinary(nil, mustDecodeHex(t, "000300003c0040004200")))
+	assert.Equal(t, pgvector.HalfVector{Vec: []float32{1, 2, 3}, Status: pgtype.Present}, r)
+	require.NoError(t, r.DecodeBinary(nil, mustDecodeHex(t, "000100000001")))
+	assert.Equal(t, float32(5.9604645e-08), r.Vec[0])
+	require.Error(t, r.DecodeBinary(nil, mustDecodeHex(t, "000300003c00")))
+
+	require.NoError(t, r.DecodeText(nil, []byte("[0.1,2]")))
+	assert.Equal(t, pgvector.HalfVector{Vec: []float32{0.099975586, 2}, Status: pgtype.Present}, r)
+
+	buf, err = pgvector.HalfVector{Vec: []float32{0.1, 2}, Status: pgtype.Present}.EncodeText(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "[0.099975586,2]", string(buf))
+}
+
+func TestHalfVectorSetAssignTo(t *testing.T) {
+	var v pgvector.HalfVector
+	require.NoError(t, v.Set([]float32{1, 2}))
+	assert.Equal(t, pgvector.HalfVector{Vec: []float32{1, 2}, Status: pgtype.Present}, v)
+	require.NoError(t, v.Set("[3,4]"))
+	assert.Equal(t, pgvector.HalfVector{Vec: []float32{3, 4}, Status: pgtype.Present}, v)
+	require.NoError(t, v.Set(nil))
+	assert.Equal(t, pgvector.HalfVector{Status: pgtype.Null}, v)
+	require.Error(t, v.Set(1))
+
+	src := pgvector.HalfVector{Vec: []float32{1, 2}, Status: pgtype.Present}
+	var f32s []float32
+	require.NoError(t, src.AssignTo(&f32s))
+	assert.Equal(t, []float32{1, 2}, f32s)
+}
+
+func mustDecodeHex(t testing.TB, s string) []byte {
+	buf, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return buf
+}
@@ -0,0 +1,270 @@
+package pgvector
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/jackc/pgio"
+	"github.com/jackc/pgtype"
+)
+
+// SparseVector is the pgvector sparsevec type. Indices are 0-based and strictly increasing. Values holds the element at
+// each index, and all other elements are zero.
+type SparseVector struct {
+	Dim     int32
+	Indices []int32
+	Values  []float32
+	Status  pgtype.Status
+}
+
+// Set converts src to dst. src may be nil, a SparseVector, a string in the sparsevec text format, or a dense vector
+// accepted by Vector.Set. Zero elements of a dense vector are omitted.
+func (dst *SparseVector) Set(src interface{}) error {
+	if value, ok := src.(SparseVector); ok {
+		*dst = value
+		return nil
+	}
+	if value, ok := src.(string); ok {
+		return dst.DecodeText(nil, []byte(value))
+	}
+
+	var v Vector
+	err := v.Set(src)
+	if err != nil {
+		return fmt.Errorf("cannot convert %v to SparseVector", src)
+	}
+	if v.Status != pgtype.Present {
+		*dst = SparseVector{Status: v.Status}
+		return nil
+	}
+	if len(v.Vec) > math.MaxInt32 {
+		return fmt.Errorf("sparsevec cannot have more than %d dimensions", math.MaxInt32)
+	}
+
+	*dst = SparseVector{Dim: int32(len(v.Vec)), Status: pgtype.Present}
+	for i, f := range v.Vec {
+		if f != 0 {
+			dst.Indices = append(dst.Indices, int32(i))
+			dst.Values = append(dst.Values, f)
+		}
+	}
+	return nil
+}
+
+func (dst SparseVector) Get() interface{} {
+	switch dst.Status {
+	case pgtype.Present:
+		return dst
+	case pgtype.Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+// AssignTo assigns src to dst. dst may be a *SparseVector or a *[]float32 or *[]float64 that receives the dense
+// vector.
+func (src *SparseVector) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case pgtype.Present:
+		if v, ok := dst.(*SparseVector); ok {
+			*v = *src
+			return nil
+		}
+		err := src.validate()
+		if err != nil {
+			return err
+		}
+		return assignFloat32s(src.dense(), dst, src.AssignTo)
+	case pgtype.Null:
+		return pgtype.NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot assign %v into %T", src, dst)
+}
+
+// dense returns src as a dense vector. src must be valid.
+func (src SparseVector) dense() []float32 {
+	vec := make([]float32, src.Dim)
+	for i, idx := range src.Indices {
+		vec[idx] = src.Values[i]
+	}
+	return vec
+}
+
+func (src SparseVector) validate() error {
+	if src.Dim < 0 {
+		return fmt.Errorf("invalid sparsevec dimensions: %d", src.Dim)
+	}
+	if len(src.Indices) != len(src.Values) {
+		return fmt.Errorf("sparsevec has %d indices and %d values", len(src.Indices), len(src.Values))
+	}
+	for i, idx := range src.Indices {
+		if idx < 0 || idx >= src.Dim {
+			return fmt.Errorf("sparsevec index %d is out of range for %d dimensions", idx, src.Dim)
+		}
+		if i > 0 && idx <= src.Indices[i-1] {
+			return errors.New("sparsevec indices must be strictly increasing")
+		}
+	}
+	return nil
+}
+
+// DecodeText decodes the sparsevec text format, e.g. {1:1.5,3:2}/5. Indices in the text format are 1-based.
+func (dst *SparseVector) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = SparseVector{Status: pgtype.Null}
+		return nil
+	}
+
+	src = bytes.TrimSpace(src)
+	end := bytes.LastIndexByte(src, '/')
+	if len(src) < 2 || src[0] != '{' || end < 1 || src[end-1] != '}' {
+		return fmt.Errorf("invalid sparsevec: %q", src)
+	}
+
+	dim, err := strconv.ParseInt(string(bytes.TrimSpace(src[end+1:])), 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid sparsevec dimensions: %w", err)
+	}
+	sv := SparseVector{Dim: int32(dim), Status: pgtype.Present}
+
+	elems := bytes.TrimSpace(src[1 : end-1])
+	if len(elems) > 0 {
+		for _, elem := range bytes.Split(elems, []byte{','}) {
+			colon := bytes.IndexByte(elem, ':')
+			if colon < 0 {
+				return fmt.Errorf("invalid sparsevec element: %q", elem)
+			}
+			idx, err := strconv.ParseInt(string(bytes.TrimSpace(elem[:colon])), 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid sparsevec index: %w", err)
+			}
+			f, err := strconv.ParseFloat(string(bytes.TrimSpace(elem[colon+1:])), 32)
+			if err != nil {
+				return fmt.Errorf("invalid sparsevec value: %w", err)
+			}
+			sv.Indices = append(sv.Indices, int32(idx-1))
+			sv.Values = append(sv.Values, float32(f))
+		}
+	}
+
+	err = sv.validate()
+	if err != nil {
+		return err
+	}
+
+	*dst = sv
+	return nil
+}
+
+// DecodeBinary decodes the sparsevec binary format: the int32 number of dimensions, the int32 number of non-zero
+// elements, an unused int32, the int32 0-based indices and the float4 values.
+func (dst *SparseVector) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = SparseVector{Status: pgtype.Null}
+		return nil
+	}
+
+	if len(src) < 12 {
+		return fmt.Errorf("invalid length for sparsevec: %v", len(src))
+	}
+	dim := int32(binary.BigEndian.Uint32(src))
+	nnz := int(int32(binary.BigEndian.Uint32(src[4:])))
+	src = src[12:]
+	if nnz < 0 || len(src) != nnz*8 {
+		return fmt.Errorf("invalid length for sparsevec with %d non-zero elements: %v", nnz, len(src))
+	}
+
+	sv := SparseVector{Dim: dim, Indices: make([]int32, nnz), Values: make([]float32, nnz), Status: pgtype.Present}
+	for i := 0; i < nnz; i++ {
+		sv.Indices[i] = int32(binary.BigEndian.Uint32(src[i*4:]))
+		sv.Values[i] = math.Float32frombits(binary.BigEndian.Uint32(src[(nnz+i)*4:]))
+	}
+
+	err := sv.validate()
+	if err != nil {
+		return err
+	}
+
+	*dst = sv
+	return nil
+}
+
+func (src SparseVector) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+
+	err := src.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	buf = append(buf, '{')
+	for i, idx := range src.Indices {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = strconv.AppendInt(buf, int64(idx)+1, 10)
+		buf = append(buf, ':')
+		buf = strconv.AppendFloat(buf, float64(src.Values[i]), 'g', -1, 32)
+	}
+	buf = append(buf, "}/"...)
+	return strconv.AppendInt(buf, int64(src.Dim), 10), nil
+}
+
+func (src SparseVector) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+
+	err := src.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	buf = pgio.AppendInt32(buf, src.Dim)
+	buf = pgio.AppendInt32(buf, int32(len(src.Indices)))
+	buf = pgio.AppendInt32(buf, 0)
+	for _, idx := range src.Indices {
+		buf = pgio.AppendInt32(buf, idx)
+	}
+	for _, f := range src.Values {
+		buf = pgio.AppendUint32(buf, math.Float32bits(f))
+	}
+	return buf, nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *SparseVector) Scan(src interface{}) error {
+	if src == nil {
+		*dst = SparseVector{Status: pgtype.Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		return dst.DecodeText(nil, src)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src SparseVector) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
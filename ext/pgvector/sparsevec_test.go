@@ -0,0 +1,75 @@
+package pgvector_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/ext/pgvector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSparseVectorCodec(t *testing.T) {
+	v := pgvector.SparseVector{Dim: 5, Indices: []int32{0, 2}, Values: []float32{1.5, 2}, Status: pgtype.Present}
+
+	buf, err := v.EncodeBinary(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "0000000500000002000000000000000000000002"+"3fc0000040000000", hex.EncodeToString(buf))
+
+	var r pgvector.SparseVector
+	require.NoError(t, r.DecodeBinary(nil, buf))
+	assert.Equal(t, v, r)
+
+	buf, err = v.EncodeText(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "{1:1.5,3:2}/5", string(buf))
+
+	require.NoError(t, r.DecodeText(nil, []byte("{1:1.5, 3:2}/5")))
+	assert.Equal(t, v, r)
+
+	empty := pgvector.SparseVector{Dim: 3, Status: pgtype.Present}
+	buf, err = empty.EncodeText(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "{}/3", string(buf))
+	require.NoError(t, r.DecodeText(nil, buf))
+	assert.Equal(t, empty, r)
+
+	for i, s := range []string{"{1:1}", "1:1/3", "{0:1}/3", "{4:1}/3", "{2:1,1:1}/3", "{1:x}/3", "{1}/3", "{1:1}/x"} {
+		require.Errorf(t, r.DecodeText(nil, []byte(s)), "%d: %s", i, s)
+	}
+	require.Error(t, r.DecodeBinary(nil, mustDecodeHex(t, "000000050000000200000000")))
+	require.Error(t, r.DecodeBinary(nil, mustDecodeHex(t, "0000000500000001000000000000000500000000")))
+
+	_, err = pgvector.SparseVector{Dim: 2, Indices: []int32{0}, Status: pgtype.Present}.EncodeBinary(nil, nil)
+	require.Error(t, err)
+	_, err = pgvector.SparseVector{Dim: 2, Indices: []int32{1, 1}, Values: []float32{1, 2}, Status: pgtype.Present}.EncodeText(nil, nil)
+	require.Error(t, err)
+}
+
+func TestSparseVectorSetAssignTo(t *testing.T) {
+	var v pgvector.SparseVector
+	require.NoError(t, v.Set([]float32{0, 1.5, 0, 2}))
+	assert.Equal(t, pgvector.SparseVector{Dim: 4, Indices: []int32{1, 3}, Values: []float32{1.5, 2}, Status: pgtype.Present}, v)
+	require.NoError(t, v.Set("{2:4}/3"))
+	assert.Equal(t, pgvector.SparseVector{Dim: 3, Indices: []int32{1}, Values: []float32{4}, Status: pgtype.Present}, v)
+	require.NoError(t, v.Set(nil))
+	assert.Equal(t, pgvector.SparseVector{Status: pgtype.Null}, v)
+	require.Error(t, v.Set(1))
+
+	src := pgvector.SparseVector{Dim: 3, Indices: []int32{1}, Values: []float32{4}, Status: pgtype.Present}
+	var f32s []float32
+	require.NoError(t, src.AssignTo(&f32s))
+	assert.Equal(t, []float32{0, 4, 0}, f32s)
+
+	var f64s []float64
+	require.NoError(t, src.AssignTo(&f64s))
+	assert.Equal(t, []float64{0, 4, 0}, f64s)
+
+	var sv pgvector.SparseVector
+	require.NoError(t, src.AssignTo(&sv))
+	assert.Equal(t, src, sv)
+
+	invalid := pgvector.SparseVector{Dim: 1, Indices: []int32{3}, Values: []float32{4}, Status: pgtype.Present}
+	require.Error(t, invalid.AssignTo(&f32s))
+}
@@ -0,0 +1,304 @@
+// Package pgvector provides the vector, halfvec and sparsevec types of the pgvector extension.
+//
+// The OIDs of the pgvector types differ between databases so the types must be registered with Register on each
+// connection.
+package pgvector
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/jackc/pgio"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/pgxtype"
+)
+
+var errUndefined = errors.New("cannot encode status undefined")
+
+// Vector is the pgvector vector type.
+type Vector struct {
+	Vec    []float32
+	Status pgtype.Status
+}
+
+// Set converts src to dst. src may be nil, a []float32, []float64, Vector, HalfVector, SparseVector or a string in the
+// vector text format.
+func (dst *Vector) Set(src interface{}) error {
+	if src == nil {
+		*dst = Vector{Status: pgtype.Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case []float32:
+		if value == nil {
+			*dst = Vector{Status: pgtype.Null}
+		} else {
+			*dst = Vector{Vec: value, Status: pgtype.Present}
+		}
+	case *[]float32:
+		if value == nil {
+			*dst = Vector{Status: pgtype.Null}
+		} else {
+			return dst.Set(*value)
+		}
+	case []float64:
+		if value == nil {
+			*dst = Vector{Status: pgtype.Null}
+		} else {
+			*dst = Vector{Vec: float64sToFloat32s(value), Status: pgtype.Present}
+		}
+	case Vector:
+		*dst = value
+	case HalfVector:
+		*dst = Vector(value)
+	case SparseVector:
+		if value.Status != pgtype.Present {
+			*dst = Vector{Status: value.Status}
+			return nil
+		}
+		err := value.validate()
+		if err != nil {
+			return err
+		}
+		*dst = Vector{Vec: value.dense(), Status: pgtype.Present}
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	default:
+		return fmt.Errorf("cannot convert %v to Vector", src)
+	}
+
+	return nil
+}
+
+func (dst Vector) Get() interface{} {
+	switch dst.Status {
+	case pgtype.Present:
+		return dst.Vec
+	case pgtype.Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *Vector) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case pgtype.Present:
+		return assignFloat32s(src.Vec, dst, src.AssignTo)
+	case pgtype.Null:
+		return pgtype.NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot assign %v into %T", src, dst)
+}
+
+// DecodeText decodes the vector text format, e.g. [1,2,3].
+func (dst *Vector) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Vector{Status: pgtype.Null}
+		return nil
+	}
+
+	vec, err := parseVectorText(src)
+	if err != nil {
+		return err
+	}
+
+	*dst = Vector{Vec: vec, Status: pgtype.Present}
+	return nil
+}
+
+// DecodeBinary decodes the vector binary format: the int16 number of dimensions, an unused int16 and the float4
+// elements.
+func (dst *Vector) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Vector{Status: pgtype.Null}
+		return nil
+	}
+
+	if len(src) < 4 {
+		return fmt.Errorf("invalid length for vector: %v", len(src))
+	}
+	dim := int(int16(binary.BigEndian.Uint16(src)))
+	src = src[4:]
+	if len(src) != dim*4 {
+		return fmt.Errorf("invalid length for vector with %d dimensions: %v", dim, len(src))
+	}
+
+	vec := make([]float32, dim)
+	for i := range vec {
+		vec[i] = math.Float32frombits(binary.BigEndian.Uint32(src[i*4:]))
+	}
+
+	*dst = Vector{Vec: vec, Status: pgtype.Present}
+	return nil
+}
+
+func (src Vector) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+
+	return appendVectorText(buf, src.Vec), nil
+}
+
+func (src Vector) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+
+	if len(src.Vec) > math.MaxInt16 {
+		return nil, fmt.Errorf("vector cannot have more than %d dimensions", math.MaxInt16)
+	}
+
+	buf = pgio.AppendInt16(buf, int16(len(src.Vec)))
+	buf = pgio.AppendInt16(buf, 0)
+	for _, f := range src.Vec {
+		buf = pgio.AppendUint32(buf, math.Float32bits(f))
+	}
+	return buf, nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Vector) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Vector{Status: pgtype.Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		return dst.DecodeText(nil, src)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Vector) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
+
+// Register looks up the OIDs of the pgvector types with conn and registers Vector, HalfVector and SparseVector as the
+// vector, halfvec and sparsevec data types in ci along with arrays of them. halfvec and sparsevec are only registered
+// if the installed version of pgvector has them. It also makes Vector, HalfVector and SparseVector map to those types.
+// The vector extension must be installed in the database.
+func Register(ctx context.Context, conn pgxtype.Querier, ci *pgtype.ConnInfo) error {
+	var oids [6]pgtype.OIDValue
+	err := conn.QueryRow(ctx, `select
+	to_regtype('vector')::oid, to_regtype('vector[]')::oid,
+	to_regtype('halfvec')::oid, to_regtype('halfvec[]')::oid,
+	to_regtype('sparsevec')::oid, to_regtype('sparsevec[]')::oid`,
+	).Scan(&oids[0], &oids[1], &oids[2], &oids[3], &oids[4], &oids[5])
+	if err != nil {
+		return err
+	}
+	if oids[0].Status != pgtype.Present || oids[1].Status != pgtype.Present {
+		return errors.New("vector type not found: is the vector extension installed?")
+	}
+
+	registerDataType(ci, "vector", oids[0].Uint, oids[1].Uint, Vector{}, func() pgtype.ValueTranscoder { return &Vector{} })
+	if oids[2].Status == pgtype.Present && oids[3].Status == pgtype.Present {
+		registerDataType(ci, "halfvec", oids[2].Uint, oids[3].Uint, HalfVector{}, func() pgtype.ValueTranscoder { return &HalfVector{} })
+	}
+	if oids[4].Status == pgtype.Present && oids[5].Status == pgtype.Present {
+		registerDataType(ci, "sparsevec", oids[4].Uint, oids[5].Uint, SparseVector{}, func() pgtype.ValueTranscoder { return &SparseVector{} })
+	}
+	return nil
+}
+
+// registerDataType registers the data type name and its array type in ci and makes defaultValue map to name.
+func registerDataType(ci *pgtype.ConnInfo, name string, oid, arrayOID uint32, defaultValue interface{}, newValue func() pgtype.ValueTranscoder) {
+	ci.RegisterDataType(pgtype.DataType{Value: newValue(), Name: name, OID: oid})
+	ci.RegisterDataType(pgtype.DataType{Value: pgtype.NewArrayType("_"+name, oid, newValue), Name: "_" + name, OID: arrayOID})
+	ci.RegisterDefaultPgType(defaultValue, name)
+}
+
+// assignFloat32s assigns vec to a *[]float32 or *[]float64. assignTo is called again with the result of
+// pgtype.GetAssignToDstType for other destinations.
+func assignFloat32s(vec []float32, dst interface{}, assignTo func(interface{}) error) error {
+	switch v := dst.(type) {
+	case *[]float32:
+		*v = make([]float32, len(vec))
+		copy(*v, vec)
+		return nil
+	case *[]float64:
+		*v = make([]float64, len(vec))
+		for i, f := range vec {
+			(*v)[i] = float64(f)
+		}
+		return nil
+	default:
+		if nextDst, retry := pgtype.GetAssignToDstType(v); retry {
+			return assignTo(nextDst)
+		}
+		return fmt.Errorf("unable to assign to %T", dst)
+	}
+}
+
+func float64sToFloat32s(src []float64) []float32 {
+	vec := make([]float32, len(src))
+	for i, f := range src {
+		vec[i] = float32(f)
+	}
+	return vec
+}
+
+// parseVectorText parses a list of floats like [1,2,3].
+func parseVectorText(src []byte) ([]float32, error) {
+	src = bytes.TrimSpace(src)
+	if len(src) < 2 || src[0] != '[' || src[len(src)-1] != ']' {
+		return nil, fmt.Errorf("invalid vector: %q", src)
+	}
+	src = src[1 : len(src)-1]
+
+	if len(bytes.TrimSpace(src)) == 0 {
+		return []float32{}, nil
+	}
+
+	vec := make([]float32, 0, bytes.Count(src, []byte{','})+1)
+	for len(src) > 0 {
+		var elem []byte
+		if i := bytes.IndexByte(src, ','); i >= 0 {
+			elem, src = src[:i], src[i+1:]
+			if len(src) == 0 {
+				return nil, errors.New("invalid vector: trailing comma")
+			}
+		} else {
+			elem, src = src, nil
+		}
+
+		f, err := strconv.ParseFloat(string(bytes.TrimSpace(elem)), 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid vector element: %w", err)
+		}
+		vec = append(vec, float32(f))
+	}
+	return vec, nil
+}
+
+func appendVectorText(buf []byte, vec []float32) []byte {
+	buf = append(buf, '[')
+	for i, f := range vec {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = strconv.AppendFloat(buf, float64(f), 'g', -1, 32)
+	}
+	return append(buf, ']')
+}
@@ -0,0 +1,159 @@
+package pgvector_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/ext/pgvector"
+	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorSet(t *testing.T) {
+	successfulTests := []struct {
+		source interface{}
+		result pgvector.Vector
+	}{
+		{source: nil, result: pgvector.Vector{Status: pgtype.Null}},
+		{source: []float32(nil), result: pgvector.Vector{Status: pgtype.Null}},
+		{source: []float32{1, 2, 3}, result: pgvector.Vector{Vec: []float32{1, 2, 3}, Status: pgtype.Present}},
+		{source: []float64{1, 2, 3}, result: pgvector.Vector{Vec: []float32{1, 2, 3}, Status: pgtype.Present}},
+		{source: "[1, 2.5,-3]", result: pgvector.Vector{Vec: []float32{1, 2.5, -3}, Status: pgtype.Present}},
+		{
+			source: pgvector.HalfVector{Vec: []float32{1, 2}, Status: pgtype.Present},
+			result: pgvector.Vector{Vec: []float32{1, 2}, Status: pgtype.Present},
+		},
+		{
+			source: pgvector.SparseVector{Dim: 3, Indices: []int32{1}, Values: []float32{5}, Status: pgtype.Present},
+			result: pgvector.Vector{Vec: []float32{0, 5, 0}, Status: pgtype.Present},
+		},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgvector.Vector
+		err := r.Set(tt.source)
+		require.NoErrorf(t, err, "%d", i)
+		assert.Equalf(t, tt.result, r, "%d", i)
+	}
+
+	var r pgvector.Vector
+	require.Error(t, r.Set(1))
+	require.Error(t, r.Set("[1,x]"))
+	require.Error(t, r.Set(pgvector.SparseVector{Dim: 1, Indices: []int32{1}, Values: []float32{1}, Status: pgtype.Present}))
+}
+
+func TestVectorAssignTo(t *testing.T) {
+	src := pgvector.Vector{Vec: []float32{1, 2, 3}, Status: pgtype.Present}
+
+	var f32s []float32
+	require.NoError(t, src.AssignTo(&f32s))
+	assert.Equal(t, []float32{1, 2, 3}, f32s)
+	f32s[0] = 10
+	assert.Equal(t, float32(1), src.Vec[0])
+
+	var f64s []float64
+	require.NoError(t, src.AssignTo(&f64s))
+	assert.Equal(t, []float64{1, 2, 3}, f64s)
+
+	var pf32s *[]float32
+	require.NoError(t, src.AssignTo(&pf32s))
+	assert.Equal(t, []float32{1, 2, 3}, *pf32s)
+
+	null := pgvector.Vector{Status: pgtype.Null}
+	require.NoError(t, null.AssignTo(&pf32s))
+	assert.Nil(t, pf32s)
+
+	var s string
+	require.Error(t, src.AssignTo(&s))
+}
+
+func TestVectorCodec(t *testing.T) {
+	v := pgvector.Vector{Vec: []float32{1, 2, 3}, Status: pgtype.Present}
+
+	buf, err := v.EncodeBinary(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "000300003f8000004000000040400000", hex.EncodeToString(buf))
+
+	var r pgvector.Vector
+	require.NoError(t, r.DecodeBinary(nil, buf))
+	assert.Equal(t, v, r)
+
+	buf, err = pgvector.Vector{Vec: []float32{1.5, -0.25, 1e-05, 1e+20}, Status: pgtype.Present}.EncodeText(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "[1.5,-0.25,1e-05,1e+20]", string(buf))
+
+	buf, err = pgvector.Vector{Vec: []float32{}, Status: pgtype.Present}.EncodeText(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "[]", string(buf))
+
+	require.NoError(t, r.DecodeText(nil, []byte("[1,2,3]")))
+	assert.Equal(t, v, r)
+
+	require.Error(t, r.DecodeBinary(nil, []byte{0, 3, 0, 0, 0}))
+	require.Error(t, r.DecodeText(nil, []byte("1,2,3")))
+	require.Error(t, r.DecodeText(nil, []byte("[1,2,]")))
+
+	val, err := v.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "[1,2,3]", val)
+	require.NoError(t, r.Scan([]byte("[4,5]")))
+	assert.Equal(t, pgvector.Vector{Vec: []float32{4, 5}, Status: pgtype.Present}, r)
+}
+
+func TestVectorTranscode(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	var available bool
+	err := conn.QueryRow(context.Background(), "select exists(select 1 from pg_available_extensions where name = 'vector')").Scan(&available)
+	require.NoError(t, err)
+	if !available {
+		t.Skip("vector extension is not available")
+	}
+	_, err = conn.Exec(context.Background(), "create extension if not exists vector")
+	require.NoError(t, err)
+
+	require.NoError(t, pgvector.Register(context.Background(), conn, conn.ConnInfo()))
+
+	for _, formatCode := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+		var v pgvector.Vector
+		src := pgvector.Vector{Vec: []float32{1, -2.5, 3e-7}, Status: pgtype.Present}
+		err := conn.QueryRow(context.Background(), "select $1::vector", testutil.ForceEncoder(src, formatCode)).Scan(&v)
+		require.NoErrorf(t, err, "%d", formatCode)
+		assert.Equalf(t, src, v, "%d", formatCode)
+
+		var hv pgvector.HalfVector
+		halfSrc := pgvector.HalfVector{Vec: []float32{1, -2.5, 0.099975586}, Status: pgtype.Present}
+		err = conn.QueryRow(context.Background(), "select $1::halfvec", testutil.ForceEncoder(halfSrc, formatCode)).Scan(&hv)
+		require.NoErrorf(t, err, "%d", formatCode)
+		assert.Equalf(t, halfSrc, hv, "%d", formatCode)
+
+		var sv pgvector.SparseVector
+		sparseSrc := pgvector.SparseVector{Dim: 5, Indices: []int32{0, 3}, Values: []float32{1.5, 2}, Status: pgtype.Present}
+		err = conn.QueryRow(context.Background(), "select $1::sparsevec", testutil.ForceEncoder(sparseSrc, formatCode)).Scan(&sv)
+		require.NoErrorf(t, err, "%d", formatCode)
+		assert.Equalf(t, sparseSrc, sv, "%d", formatCode)
+	}
+
+	var f32s []float32
+	err = conn.QueryRow(context.Background(), "select '[1,2,3]'::vector").Scan(&f32s)
+	require.NoError(t, err)
+	assert.Equal(t, []float32{1, 2, 3}, f32s)
+
+	err = conn.QueryRow(context.Background(), "select '{2:4}/3'::sparsevec").Scan(&f32s)
+	require.NoError(t, err)
+	assert.Equal(t, []float32{0, 4, 0}, f32s)
+
+	var distance float64
+	err = conn.QueryRow(context.Background(), "select $1::vector <-> '[3,4]'", pgvector.Vector{Vec: []float32{0, 0}, Status: pgtype.Present}).Scan(&distance)
+	require.NoError(t, err)
+	assert.Equal(t, 5.0, distance)
+
+	var vs []pgvector.Vector
+	err = conn.QueryRow(context.Background(), "select array['[1,2]'::vector, '[3]'::vector]").Scan(&vs)
+	require.NoError(t, err)
+	assert.Equal(t, []pgvector.Vector{{Vec: []float32{1, 2}, Status: pgtype.Present}, {Vec: []float32{3}, Status: pgtype.Present}}, vs)
+}
@@ -0,0 +1,350 @@
+package postgis
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/jackc/pgtype"
+)
+
+// EWKB is PostGIS's extension of Well-Known Binary. The geometry type code may be flagged with Z and M dimensions and
+// an SRID. ISO WKB type codes for Z, M and ZM geometries are also accepted when decoding.
+const (
+	ewkbZFlag    = 0x80000000
+	ewkbMFlag    = 0x40000000
+	ewkbSRIDFlag = 0x20000000
+
+	ewkbTypeMask = 0x0fffffff
+)
+
+// WKB byte order markers.
+const (
+	wkbXDR = 0 // big endian
+	wkbNDR = 1 // little endian
+)
+
+// MarshalEWKB returns src as EWKB in byteOrder, which must be binary.BigEndian or binary.LittleEndian. The SRID is
+// included if it is not 0. A Null value returns nil.
+func (src Geometry) MarshalEWKB(byteOrder binary.ByteOrder) ([]byte, error) {
+	switch src.Status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+
+	w := &ewkbWriter{byteOrder: byteOrder, hasZ: src.HasZ, hasM: src.HasM}
+	err := w.geom(src.Geom, src.SRID)
+	if err != nil {
+		return nil, err
+	}
+	return w.buf, nil
+}
+
+// UnmarshalEWKB decodes EWKB, ISO WKB or WKB in either byte order into dst. nil decodes to a Null value.
+func (dst *Geometry) UnmarshalEWKB(src []byte) error {
+	if src == nil {
+		*dst = Geometry{Status: pgtype.Null}
+		return nil
+	}
+
+	r := &ewkbReader{src: src}
+	g, err := r.geom(true)
+	if err != nil {
+		return err
+	}
+	if len(r.src) != 0 {
+		return fmt.Errorf("invalid EWKB: %d unexpected trailing bytes", len(r.src))
+	}
+
+	*dst = Geometry{Geom: g, SRID: r.srid, HasZ: r.hasZ, HasM: r.hasM, Status: pgtype.Present}
+	return nil
+}
+
+type ewkbWriter struct {
+	byteOrder  binary.ByteOrder
+	hasZ, hasM bool
+	buf        []byte
+}
+
+// geom writes g. srid is written if it is not 0 and must be 0 for nested geometries.
+func (w *ewkbWriter) geom(g Geom, srid int32) error {
+	switch w.byteOrder {
+	case binary.BigEndian:
+		w.buf = append(w.buf, wkbXDR)
+	case binary.LittleEndian:
+		w.buf = append(w.buf, wkbNDR)
+	default:
+		return fmt.Errorf("unsupported EWKB byte order %v", w.byteOrder)
+	}
+
+	if g.Type < PointType || g.Type > GeometryCollectionType {
+		return fmt.Errorf("invalid geometry type %d", g.Type)
+	}
+
+	typ := uint32(g.Type)
+	if w.hasZ {
+		typ |= ewkbZFlag
+	}
+	if w.hasM {
+		typ |= ewkbMFlag
+	}
+	if srid != 0 {
+		typ |= ewkbSRIDFlag
+	}
+	w.uint32(typ)
+	if srid != 0 {
+		w.uint32(uint32(srid))
+	}
+
+	switch g.Type {
+	case PointType:
+		switch len(g.Coords) {
+		case 0:
+			// PostGIS encodes an empty point with quiet NaN coordinates.
+			nan := math.Float64frombits(0x7ff8000000000000)
+			w.coord(Coord{X: nan, Y: nan, Z: nan, M: nan})
+		case 1:
+			w.coord(g.Coords[0])
+		default:
+			return fmt.Errorf("Point must have at most 1 coordinate, has %d", len(g.Coords))
+		}
+	case LineStringType:
+		w.coords(g.Coords)
+	case PolygonType:
+		w.uint32(uint32(len(g.Rings)))
+		for _, ring := range g.Rings {
+			w.coords(ring)
+		}
+	default:
+		memberType := g.Type.memberType()
+		w.uint32(uint32(len(g.Geoms)))
+		for _, member := range g.Geoms {
+			if memberType != 0 && member.Type != memberType {
+				return fmt.Errorf("%v cannot contain %v", g.Type, member.Type)
+			}
+			err := w.geom(member, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *ewkbWriter) uint32(n uint32) {
+	var b [4]byte
+	w.byteOrder.PutUint32(b[:], n)
+	w.buf = append(w.buf, b[:]...)
+}
+
+func (w *ewkbWriter) float64(f float64) {
+	var b [8]byte
+	w.byteOrder.PutUint64(b[:], math.Float64bits(f))
+	w.buf = append(w.buf, b[:]...)
+}
+
+func (w *ewkbWriter) coord(c Coord) {
+	w.float64(c.X)
+	w.float64(c.Y)
+	if w.hasZ {
+		w.float64(c.Z)
+	}
+	if w.hasM {
+		w.float64(c.M)
+	}
+}
+
+func (w *ewkbWriter) coords(coords []Coord) {
+	w.uint32(uint32(len(coords)))
+	for _, c := range coords {
+		w.coord(c)
+	}
+}
+
+type ewkbReader struct {
+	src        []byte
+	byteOrder  binary.ByteOrder
+	srid       int32
+	hasZ, hasM bool
+}
+
+// geom reads a geometry. The dimensions and SRID of the top level geometry are stored in r. Nested geometries must
+// have the same dimensions.
+func (r *ewkbReader) geom(top bool) (Geom, error) {
+	if len(r.src) < 5 {
+		return Geom{}, errors.New("invalid EWKB: unexpected end of data")
+	}
+	switch r.src[0] {
+	case wkbXDR:
+		r.byteOrder = binary.BigEndian
+	case wkbNDR:
+		r.byteOrder = binary.LittleEndian
+	default:
+		return Geom{}, fmt.Errorf("invalid EWKB byte order %d", r.src[0])
+	}
+	r.src = r.src[1:]
+
+	typ, _ := r.uint32()
+	hasZ := typ&ewkbZFlag != 0
+	hasM := typ&ewkbMFlag != 0
+	base := typ & ewkbTypeMask
+	switch base / 1000 {
+	case 0:
+	case 1:
+		hasZ = true
+	case 2:
+		hasM = true
+	case 3:
+		hasZ, hasM = true, true
+	default:
+		return Geom{}, fmt.Errorf("unsupported EWKB geometry type %d", base)
+	}
+	base %= 1000
+
+	if typ&ewkbSRIDFlag != 0 {
+		srid, err := r.uint32()
+		if err != nil {
+			return Geom{}, err
+		}
+		if top {
+			r.srid = int32(srid)
+		}
+	}
+
+	if top {
+		r.hasZ, r.hasM = hasZ, hasM
+	} else if hasZ != r.hasZ || hasM != r.hasM {
+		return Geom{}, errors.New("invalid EWKB: nested geometry has different dimensions")
+	}
+
+	g := Geom{Type: GeometryType(base)}
+	switch g.Type {
+	case PointType:
+		c, err := r.coord()
+		if err != nil {
+			return Geom{}, err
+		}
+		if !(math.IsNaN(c.X) && math.IsNaN(c.Y)) {
+			g.Coords = []Coord{c}
+		}
+	case LineStringType:
+		coords, err := r.coords()
+		if err != nil {
+			return Geom{}, err
+		}
+		g.Coords = coords
+	case PolygonType:
+		n, err := r.count(4)
+		if err != nil {
+			return Geom{}, err
+		}
+		if n > 0 {
+			g.Rings = make([][]Coord, n)
+		}
+		for i := range g.Rings {
+			g.Rings[i], err = r.coords()
+			if err != nil {
+				return Geom{}, err
+			}
+		}
+	case MultiPointType, MultiLineStringType, MultiPolygonType, GeometryCollectionType:
+		n, err := r.count(9)
+		if err != nil {
+			return Geom{}, err
+		}
+		if n > 0 {
+			g.Geoms = make([]Geom, n)
+		}
+		memberType := g.Type.memberType()
+		for i := range g.Geoms {
+			g.Geoms[i], err = r.geom(false)
+			if err != nil {
+				return Geom{}, err
+			}
+			if memberType != 0 && g.Geoms[i].Type != memberType {
+				return Geom{}, fmt.Errorf("invalid EWKB: %v cannot contain %v", g.Type, g.Geoms[i].Type)
+			}
+		}
+	default:
+		return Geom{}, fmt.Errorf("unsupported EWKB geometry type %d", base)
+	}
+
+	return g, nil
+}
+
+func (r *ewkbReader) uint32() (uint32, error) {
+	if len(r.src) < 4 {
+		return 0, errors.New("invalid EWKB: unexpected end of data")
+	}
+	n := r.byteOrder.Uint32(r.src)
+	r.src = r.src[4:]
+	return n, nil
+}
+
+// count reads a number of items that each take at least minSize bytes. It fails if the remaining data is too short so
+// a corrupt count cannot cause a huge allocation.
+func (r *ewkbReader) count(minSize int) (int, error) {
+	n, err := r.uint32()
+	if err != nil {
+		return 0, err
+	}
+	if uint64(n)*uint64(minSize) > uint64(len(r.src)) {
+		return 0, errors.New("invalid EWKB: unexpected end of data")
+	}
+	return int(n), nil
+}
+
+func (r *ewkbReader) coordSize() int {
+	size := 16
+	if r.hasZ {
+		size += 8
+	}
+	if r.hasM {
+		size += 8
+	}
+	return size
+}
+
+func (r *ewkbReader) coord() (Coord, error) {
+	if len(r.src) < r.coordSize() {
+		return Coord{}, errors.New("invalid EWKB: unexpected end of data")
+	}
+
+	next := func() float64 {
+		f := math.Float64frombits(r.byteOrder.Uint64(r.src))
+		r.src = r.src[8:]
+		return f
+	}
+
+	c := Coord{X: next(), Y: next()}
+	if r.hasZ {
+		c.Z = next()
+	}
+	if r.hasM {
+		c.M = next()
+	}
+	return c, nil
+}
+
+func (r *ewkbReader) coords() ([]Coord, error) {
+	n, err := r.count(r.coordSize())
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, nil
+	}
+
+	coords := make([]Coord, n)
+	for i := range coords {
+		coords[i], err = r.coord()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return coords, nil
+}
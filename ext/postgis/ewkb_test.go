@@ -0,0 +1,177 @@
+package postgis_test
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/ext/postgis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustDecodeHex(t testing.TB, s string) []byte {
+	buf, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return buf
+}
+
+func point(x, y float64) postgis.Geom {
+	return postgis.Geom{Type: postgis.PointType, Coords: []postgis.Coord{{X: x, Y: y}}}
+}
+
+func TestGeometryEWKB(t *testing.T) {
+	// The expected values are the output of PostGIS.
+	tests := []struct {
+		ewkb string
+		geom postgis.Geometry
+	}{
+		{
+			// SRID=4326;POINT(1 2)
+			ewkb: "0101000020E6100000000000000000F03F0000000000000040",
+			geom: postgis.Geometry{Geom: point(1, 2), SRID: 4326, Status: pgtype.Present},
+		},
+		{
+			// POINT Z (1 2 3)
+			ewkb: "0101000080000000000000F03F00000000000000400000000000000840",
+			geom: postgis.Geometry{
+				Geom:   postgis.Geom{Type: postgis.PointType, Coords: []postgis.Coord{{X: 1, Y: 2, Z: 3}}},
+				HasZ:   true,
+				Status: pgtype.Present,
+			},
+		},
+		{
+			// POINT EMPTY
+			ewkb: "0101000000000000000000F87F000000000000F87F",
+			geom: postgis.Geometry{Geom: postgis.Geom{Type: postgis.PointType}, Status: pgtype.Present},
+		},
+		{
+			// LINESTRING(0 0,1 1)
+			ewkb: "01020000000200000000000000000000000000000000000000000000000000F03F000000000000F03F",
+			geom: postgis.Geometry{
+				Geom:   postgis.Geom{Type: postgis.LineStringType, Coords: []postgis.Coord{{X: 0, Y: 0}, {X: 1, Y: 1}}},
+				Status: pgtype.Present,
+			},
+		},
+		{
+			// GEOMETRYCOLLECTION EMPTY
+			ewkb: "010700000000000000",
+			geom: postgis.Geometry{Geom: postgis.Geom{Type: postgis.GeometryCollectionType}, Status: pgtype.Present},
+		},
+		{
+			// MULTIPOINT M ((1 2 3))
+			ewkb: "0104000040010000000101000040000000000000F03F00000000000000400000000000000840",
+			geom: postgis.Geometry{
+				Geom: postgis.Geom{
+					Type:  postgis.MultiPointType,
+					Geoms: []postgis.Geom{{Type: postgis.PointType, Coords: []postgis.Coord{{X: 1, Y: 2, M: 3}}}},
+				},
+				HasM:   true,
+				Status: pgtype.Present,
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		var g postgis.Geometry
+		err := g.DecodeText(nil, []byte(tt.ewkb))
+		require.NoErrorf(t, err, "%d", i)
+		assert.Equalf(t, tt.geom, g, "%d", i)
+
+		buf, err := tt.geom.EncodeText(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+		assert.Equalf(t, tt.ewkb, string(buf), "%d", i)
+
+		buf, err = tt.geom.EncodeBinary(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+		assert.Equalf(t, mustDecodeHex(t, tt.ewkb), buf, "%d", i)
+	}
+}
+
+func TestGeometryEWKBRoundTrip(t *testing.T) {
+	ring := []postgis.Coord{{X: 0, Y: 0, Z: 1, M: 2}, {X: 4, Y: 0, Z: 1, M: 2}, {X: 4, Y: 4, Z: 1, M: 2}, {X: 0, Y: 0, Z: 1, M: 2}}
+	hole := []postgis.Coord{{X: 1, Y: 1, Z: 1, M: 2}, {X: 2, Y: 1, Z: 1, M: 2}, {X: 2, Y: 2, Z: 1, M: 2}, {X: 1, Y: 1, Z: 1, M: 2}}
+	polygon := postgis.Geom{Type: postgis.PolygonType, Rings: [][]postgis.Coord{ring, hole}}
+	lineString := postgis.Geom{Type: postgis.LineStringType, Coords: ring}
+	pointZM := postgis.Geom{Type: postgis.PointType, Coords: []postgis.Coord{{X: 1, Y: 2, Z: 3, M: 4}}}
+
+	geoms := []postgis.Geom{
+		pointZM,
+		lineString,
+		polygon,
+		{Type: postgis.MultiPointType, Geoms: []postgis.Geom{pointZM, pointZM}},
+		{Type: postgis.MultiLineStringType, Geoms: []postgis.Geom{lineString}},
+		{Type: postgis.MultiPolygonType, Geoms: []postgis.Geom{polygon, polygon}},
+		{Type: postgis.GeometryCollectionType, Geoms: []postgis.Geom{
+			pointZM,
+			polygon,
+			{Type: postgis.GeometryCollectionType, Geoms: []postgis.Geom{lineString}},
+		}},
+	}
+
+	for i, geom := range geoms {
+		for _, byteOrder := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+			src := postgis.Geometry{Geom: geom, SRID: 3857, HasZ: true, HasM: true, Status: pgtype.Present}
+			buf, err := src.MarshalEWKB(byteOrder)
+			require.NoErrorf(t, err, "%d %v", i, byteOrder)
+
+			var dst postgis.Geometry
+			err = dst.UnmarshalEWKB(buf)
+			require.NoErrorf(t, err, "%d %v", i, byteOrder)
+			assert.Equalf(t, src, dst, "%d %v", i, byteOrder)
+		}
+	}
+}
+
+func TestGeometryUnmarshalISOWKB(t *testing.T) {
+	// ISO WKB POINT Z (1 2 3)
+	var g postgis.Geometry
+	err := g.UnmarshalEWKB(mustDecodeHex(t, "01e9030000000000000000f03f00000000000000400000000000000840"))
+	require.NoError(t, err)
+	assert.Equal(t, postgis.Geometry{
+		Geom:   postgis.Geom{Type: postgis.PointType, Coords: []postgis.Coord{{X: 1, Y: 2, Z: 3}}},
+		HasZ:   true,
+		Status: pgtype.Present,
+	}, g)
+}
+
+func TestGeometryEWKBErrors(t *testing.T) {
+	for i, s := range []string{
+		"",
+		"02",
+		"0108000000",
+		"0101000000000000000000f03f",
+		"0101000000000000000000f03f000000000000004000",
+		"0102000000ffffffff",
+		// MULTIPOINT containing a LINESTRING.
+		"010400000001000000010200000000000000",
+		// MULTIPOINT Z containing a 2D POINT.
+		"0104000080010000000101000000000000000000f03f0000000000000040",
+	} {
+		var g postgis.Geometry
+		err := g.UnmarshalEWKB(mustDecodeHex(t, s))
+		require.Errorf(t, err, "%d", i)
+	}
+
+	var g postgis.Geometry
+	require.Error(t, g.DecodeText(nil, []byte("not hex")))
+
+	_, err := postgis.Geometry{Geom: postgis.Geom{Type: 8}, Status: pgtype.Present}.MarshalEWKB(binary.LittleEndian)
+	require.Error(t, err)
+	_, err = postgis.Geometry{
+		Geom:   postgis.Geom{Type: postgis.MultiPolygonType, Geoms: []postgis.Geom{point(1, 2)}},
+		Status: pgtype.Present,
+	}.MarshalEWKB(binary.LittleEndian)
+	require.Error(t, err)
+	_, err = postgis.Geometry{Geom: point(1, 2), Status: pgtype.Present}.MarshalEWKB(nil)
+	require.Error(t, err)
+
+	buf, err := postgis.Geometry{Geom: postgis.Geom{Type: postgis.PointType}, Status: pgtype.Present}.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(buf), "0101000000"))
+	var empty postgis.Geometry
+	require.NoError(t, empty.DecodeText(nil, buf))
+	assert.Empty(t, empty.Geom.Coords)
+}
@@ -0,0 +1,369 @@
+// Package postgis provides the PostGIS geometry and geography types.
+//
+// The OIDs of the PostGIS types differ between databases so the types must be registered with Register on each
+// connection.
+package postgis
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/pgxtype"
+)
+
+var errUndefined = errors.New("cannot encode status undefined")
+
+// GeometryType is the type of a Geom. The values are the WKB type codes.
+type GeometryType uint32
+
+const (
+	PointType              GeometryType = 1
+	LineStringType         GeometryType = 2
+	PolygonType            GeometryType = 3
+	MultiPointType         GeometryType = 4
+	MultiLineStringType    GeometryType = 5
+	MultiPolygonType       GeometryType = 6
+	GeometryCollectionType GeometryType = 7
+)
+
+func (t GeometryType) String() string {
+	switch t {
+	case PointType:
+		return "Point"
+	case LineStringType:
+		return "LineString"
+	case PolygonType:
+		return "Polygon"
+	case MultiPointType:
+		return "MultiPoint"
+	case MultiLineStringType:
+		return "MultiLineString"
+	case MultiPolygonType:
+		return "MultiPolygon"
+	case GeometryCollectionType:
+		return "GeometryCollection"
+	}
+	return fmt.Sprintf("GeometryType(%d)", uint32(t))
+}
+
+// memberType returns the type of the members of a multi geometry or 0 if t may contain any type.
+func (t GeometryType) memberType() GeometryType {
+	switch t {
+	case MultiPointType:
+		return PointType
+	case MultiLineStringType:
+		return LineStringType
+	case MultiPolygonType:
+		return PolygonType
+	}
+	return 0
+}
+
+// Coord is a coordinate. Z and M are only meaningful if the geometry has those dimensions.
+type Coord struct {
+	X, Y, Z, M float64
+}
+
+// Geom is a geometry of any type. Which fields are used depends on Type.
+type Geom struct {
+	Type GeometryType
+
+	// Coords is the coordinate of a Point or the coordinates of a LineString. An empty Point has no coordinates.
+	Coords []Coord
+
+	// Rings are the rings of a Polygon. The first ring is the exterior ring and the others are holes.
+	Rings [][]Coord
+
+	// Geoms are the members of a MultiPoint, MultiLineString, MultiPolygon or GeometryCollection.
+	Geoms []Geom
+}
+
+// Geometry is the PostGIS geometry type. SRID is 0 if the spatial reference system is unknown. HasZ and HasM apply to
+// the whole geometry including all members of a collection.
+type Geometry struct {
+	Geom   Geom
+	SRID   int32
+	HasZ   bool
+	HasM   bool
+	Status pgtype.Status
+}
+
+// Set converts src to dst. src may be nil, a Geometry or Geography, EWKB as []byte, hex EWKB as a string, or a pgtype
+// geometric type that can be marshaled to WKB such as pgtype.Point or pgtype.Polygon.
+func (dst *Geometry) Set(src interface{}) error {
+	if src == nil {
+		*dst = Geometry{Status: pgtype.Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case Geometry:
+		*dst = value
+	case *Geometry:
+		if value == nil {
+			*dst = Geometry{Status: pgtype.Null}
+		} else {
+			*dst = *value
+		}
+	case Geography:
+		*dst = Geometry(value)
+	case *Geography:
+		if value == nil {
+			*dst = Geometry{Status: pgtype.Null}
+		} else {
+			*dst = Geometry(*value)
+		}
+	case []byte:
+		return dst.UnmarshalEWKB(value)
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	case interface {
+		MarshalWKB(binary.ByteOrder) ([]byte, error)
+	}:
+		buf, err := value.MarshalWKB(binary.LittleEndian)
+		if err != nil {
+			return err
+		}
+		return dst.UnmarshalEWKB(buf)
+	default:
+		return fmt.Errorf("cannot convert %v to Geometry", src)
+	}
+
+	return nil
+}
+
+func (dst Geometry) Get() interface{} {
+	switch dst.Status {
+	case pgtype.Present:
+		return dst
+	case pgtype.Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+// AssignTo assigns src to dst. dst may be a *Geometry, *Geography, *[]byte for EWKB, *string for hex EWKB, or a pgtype
+// geometric type that can be unmarshaled from WKB such as *pgtype.Point. The latter requires a two-dimensional geometry
+// of a matching type and discards the SRID.
+func (src *Geometry) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case pgtype.Present:
+		switch v := dst.(type) {
+		case *Geometry:
+			*v = *src
+			return nil
+		case *Geography:
+			*v = Geography(*src)
+			return nil
+		case *[]byte:
+			buf, err := src.MarshalEWKB(binary.LittleEndian)
+			if err != nil {
+				return err
+			}
+			*v = buf
+			return nil
+		case *string:
+			buf, err := src.EncodeText(nil, nil)
+			if err != nil {
+				return err
+			}
+			*v = string(buf)
+			return nil
+		case interface{ UnmarshalWKB([]byte) error }:
+			if src.HasZ || src.HasM {
+				return fmt.Errorf("cannot assign geometry with Z or M dimensions to %T", dst)
+			}
+			buf, err := Geometry{Geom: src.Geom, Status: pgtype.Present}.MarshalEWKB(binary.LittleEndian)
+			if err != nil {
+				return err
+			}
+			return v.UnmarshalWKB(buf)
+		default:
+			if nextDst, retry := pgtype.GetAssignToDstType(v); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case pgtype.Null:
+		return pgtype.NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot assign %v into %T", src, dst)
+}
+
+// DecodeText decodes hex EWKB, which is the PostGIS text output format.
+func (dst *Geometry) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Geometry{Status: pgtype.Null}
+		return nil
+	}
+
+	buf := make([]byte, hex.DecodedLen(len(src)))
+	_, err := hex.Decode(buf, src)
+	if err != nil {
+		return fmt.Errorf("invalid hex EWKB: %w", err)
+	}
+
+	return dst.UnmarshalEWKB(buf)
+}
+
+// DecodeBinary decodes EWKB.
+func (dst *Geometry) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	return dst.UnmarshalEWKB(src)
+}
+
+// EncodeText encodes src as little endian hex EWKB with upper case digits like PostGIS.
+func (src Geometry) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	ewkb, err := src.MarshalEWKB(binary.LittleEndian)
+	if err != nil || ewkb == nil {
+		return nil, err
+	}
+
+	const digits = "0123456789ABCDEF"
+	for _, b := range ewkb {
+		buf = append(buf, digits[b>>4], digits[b&0x0f])
+	}
+	return buf, nil
+}
+
+// EncodeBinary encodes src as little endian EWKB.
+func (src Geometry) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	ewkb, err := src.MarshalEWKB(binary.LittleEndian)
+	if err != nil || ewkb == nil {
+		return nil, err
+	}
+	return append(buf, ewkb...), nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Geometry) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Geometry{Status: pgtype.Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		return dst.DecodeText(nil, src)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Geometry) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
+
+// Geography is the PostGIS geography type. It has the same representation as Geometry. PostGIS assumes SRID 4326
+// when a geography is sent with SRID 0.
+type Geography Geometry
+
+// Set converts src to dst. It accepts the same values as Geometry.Set.
+func (dst *Geography) Set(src interface{}) error {
+	return (*Geometry)(dst).Set(src)
+}
+
+func (dst Geography) Get() interface{} {
+	switch dst.Status {
+	case pgtype.Present:
+		return dst
+	case pgtype.Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+// AssignTo assigns src to dst. It accepts the same destinations as Geometry.AssignTo.
+func (src *Geography) AssignTo(dst interface{}) error {
+	return (*Geometry)(src).AssignTo(dst)
+}
+
+// MarshalEWKB returns src as EWKB in byteOrder. See Geometry.MarshalEWKB.
+func (src Geography) MarshalEWKB(byteOrder binary.ByteOrder) ([]byte, error) {
+	return Geometry(src).MarshalEWKB(byteOrder)
+}
+
+// UnmarshalEWKB decodes EWKB, ISO WKB or WKB into dst. See Geometry.UnmarshalEWKB.
+func (dst *Geography) UnmarshalEWKB(src []byte) error {
+	return (*Geometry)(dst).UnmarshalEWKB(src)
+}
+
+// DecodeText decodes hex EWKB, which is the PostGIS text output format.
+func (dst *Geography) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	return (*Geometry)(dst).DecodeText(ci, src)
+}
+
+// DecodeBinary decodes EWKB.
+func (dst *Geography) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	return (*Geometry)(dst).DecodeBinary(ci, src)
+}
+
+// EncodeText encodes src as little endian hex EWKB with upper case digits like PostGIS.
+func (src Geography) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	return Geometry(src).EncodeText(ci, buf)
+}
+
+// EncodeBinary encodes src as little endian EWKB.
+func (src Geography) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	return Geometry(src).EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Geography) Scan(src interface{}) error {
+	return (*Geometry)(dst).Scan(src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Geography) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
+
+// Register looks up the OIDs of the PostGIS types with conn and registers Geometry and Geography as the geometry and
+// geography data types in ci along with arrays of them. It also makes Geometry and Geography map to those types. The
+// postgis extension must be installed in the database.
+func Register(ctx context.Context, conn pgxtype.Querier, ci *pgtype.ConnInfo) error {
+	var oids [4]pgtype.OIDValue
+	err := conn.QueryRow(ctx,
+		"select to_regtype('geometry')::oid, to_regtype('geometry[]')::oid, to_regtype('geography')::oid, to_regtype('geography[]')::oid",
+	).Scan(&oids[0], &oids[1], &oids[2], &oids[3])
+	if err != nil {
+		return err
+	}
+	for _, oid := range oids {
+		if oid.Status != pgtype.Present {
+			return errors.New("postgis types not found: is the postgis extension installed?")
+		}
+	}
+
+	RegisterDataTypes(ci, oids[0].Uint, oids[1].Uint, oids[2].Uint, oids[3].Uint)
+	return nil
+}
+
+// RegisterDataTypes registers Geometry and Geography with known OIDs in ci. Most callers should use Register instead.
+// PostGIS arrays use ':' rather than ',' to separate elements in the text format.
+func RegisterDataTypes(ci *pgtype.ConnInfo, geometryOID, geometryArrayOID, geographyOID, geographyArrayOID uint32) {
+	ci.RegisterDataType(pgtype.DataType{Value: &Geometry{}, Name: "geometry", OID: geometryOID})
+	ci.RegisterDataType(pgtype.DataType{
+		Value: pgtype.NewArrayTypeWithDelimiter("_geometry", geometryOID, ':', func() pgtype.ValueTranscoder { return &Geometry{} }),
+		Name:  "_geometry",
+		OID:   geometryArrayOID,
+	})
+	ci.RegisterDataType(pgtype.DataType{Value: &Geography{}, Name: "geography", OID: geographyOID})
+	ci.RegisterDataType(pgtype.DataType{
+		Value: pgtype.NewArrayTypeWithDelimiter("_geography", geographyOID, ':', func() pgtype.ValueTranscoder { return &Geography{} }),
+		Name:  "_geography",
+		OID:   geographyArrayOID,
+	})
+	ci.RegisterDefaultPgType(Geometry{}, "geometry")
+	ci.RegisterDefaultPgType(Geography{}, "geography")
+}
@@ -0,0 +1,226 @@
+package postgis_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/ext/postgis"
+	"github.com/jackc/pgtype/testutil"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeometrySet(t *testing.T) {
+	expected := postgis.Geometry{Geom: point(1, 2), SRID: 4326, Status: pgtype.Present}
+
+	successfulTests := []struct {
+		source interface{}
+		result postgis.Geometry
+	}{
+		{source: nil, result: postgis.Geometry{Status: pgtype.Null}},
+		{source: expected, result: expected},
+		{source: &expected, result: expected},
+		{source: postgis.Geography(expected), result: expected},
+		{source: "0101000020E6100000000000000000F03F0000000000000040", result: expected},
+		{source: mustDecodeHex(t, "0101000020E6100000000000000000F03F0000000000000040"), result: expected},
+		{
+			source: pgtype.Point{P: pgtype.Vec2{X: 1, Y: 2}, Status: pgtype.Present},
+			result: postgis.Geometry{Geom: point(1, 2), Status: pgtype.Present},
+		},
+		{
+			source: pgtype.Polygon{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Status: pgtype.Present},
+			result: postgis.Geometry{
+				Geom: postgis.Geom{
+					Type:  postgis.PolygonType,
+					Rings: [][]postgis.Coord{{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 0}}},
+				},
+				Status: pgtype.Present,
+			},
+		},
+	}
+
+	for i, tt := range successfulTests {
+		var r postgis.Geometry
+		err := r.Set(tt.source)
+		require.NoErrorf(t, err, "%d", i)
+		assert.Equalf(t, tt.result, r, "%d", i)
+	}
+
+	var r postgis.Geometry
+	require.Error(t, r.Set(1))
+	require.Error(t, r.Set("POINT(1 2)"))
+}
+
+func TestGeometryAssignTo(t *testing.T) {
+	src := postgis.Geometry{Geom: point(1, 2), SRID: 4326, Status: pgtype.Present}
+
+	var g postgis.Geometry
+	require.NoError(t, src.AssignTo(&g))
+	assert.Equal(t, src, g)
+
+	var geog postgis.Geography
+	require.NoError(t, src.AssignTo(&geog))
+	assert.Equal(t, postgis.Geography(src), geog)
+
+	var s string
+	require.NoError(t, src.AssignTo(&s))
+	assert.Equal(t, "0101000020E6100000000000000000F03F0000000000000040", s)
+
+	var buf []byte
+	require.NoError(t, src.AssignTo(&buf))
+	assert.Equal(t, mustDecodeHex(t, "0101000020E6100000000000000000F03F0000000000000040"), buf)
+
+	var p pgtype.Point
+	require.NoError(t, src.AssignTo(&p))
+	assert.Equal(t, pgtype.Point{P: pgtype.Vec2{X: 1, Y: 2}, Status: pgtype.Present}, p)
+
+	var lseg pgtype.Lseg
+	require.Error(t, src.AssignTo(&lseg))
+
+	pointZ := postgis.Geometry{Geom: point(1, 2), HasZ: true, Status: pgtype.Present}
+	require.Error(t, pointZ.AssignTo(&p))
+
+	var ps *string
+	null := postgis.Geometry{Status: pgtype.Null}
+	require.NoError(t, null.AssignTo(&ps))
+	assert.Nil(t, ps)
+}
+
+func TestGeography(t *testing.T) {
+	var g postgis.Geography
+	require.NoError(t, g.Set("0101000020E6100000000000000000F03F0000000000000040"))
+	assert.Equal(t, postgis.Geography{Geom: point(1, 2), SRID: 4326, Status: pgtype.Present}, g)
+	assert.Equal(t, g, g.Get())
+
+	buf, err := g.EncodeText(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "0101000020E6100000000000000000F03F0000000000000040", string(buf))
+
+	var geom postgis.Geometry
+	require.NoError(t, g.AssignTo(&geom))
+	assert.Equal(t, postgis.Geometry(g), geom)
+
+	require.NoError(t, g.Scan(nil))
+	assert.Equal(t, postgis.Geography{Status: pgtype.Null}, g)
+	assert.Nil(t, g.Get())
+}
+
+func TestGeometryDatabaseSQL(t *testing.T) {
+	src := postgis.Geometry{Geom: point(1, 2), SRID: 4326, Status: pgtype.Present}
+
+	v, err := src.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "0101000020E6100000000000000000F03F0000000000000040", v)
+
+	var dst postgis.Geometry
+	require.NoError(t, dst.Scan([]byte("0101000020E6100000000000000000F03F0000000000000040")))
+	assert.Equal(t, src, dst)
+
+	v, err = postgis.Geometry{Status: pgtype.Null}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestGeometryArrayText(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	postgis.RegisterDataTypes(ci, 100001, 100002, 100003, 100004)
+
+	const text = "{0101000000000000000000F03F0000000000000040:010100000000000000000008400000000000001040:NULL}"
+	geoms := []*postgis.Geometry{
+		{Geom: point(1, 2), Status: pgtype.Present},
+		{Geom: point(3, 4), Status: pgtype.Present},
+		nil,
+	}
+
+	for _, name := range []string{"_geometry", "_geography"} {
+		dt, ok := ci.DataTypeForName(name)
+		require.Truef(t, ok, "%s", name)
+
+		src := pgtype.NewValue(dt.Value)
+		require.NoErrorf(t, src.Set(geoms), "%s", name)
+		buf, err := src.(pgtype.TextEncoder).EncodeText(ci, nil)
+		require.NoErrorf(t, err, "%s", name)
+		assert.Equalf(t, text, string(buf), "%s", name)
+
+		dst := pgtype.NewValue(dt.Value)
+		require.NoErrorf(t, dst.(pgtype.TextDecoder).DecodeText(ci, []byte(text)), "%s", name)
+		var result []*postgis.Geometry
+		require.NoErrorf(t, dst.AssignTo(&result), "%s", name)
+		assert.Equalf(t, geoms, result, "%s", name)
+	}
+}
+
+func TestGeometryTranscode(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	var available bool
+	err := conn.QueryRow(context.Background(), "select exists(select 1 from pg_available_extensions where name = 'postgis')").Scan(&available)
+	require.NoError(t, err)
+	if !available {
+		t.Skip("postgis extension is not available")
+	}
+	_, err = conn.Exec(context.Background(), "create extension if not exists postgis")
+	require.NoError(t, err)
+
+	require.NoError(t, postgis.Register(context.Background(), conn, conn.ConnInfo()))
+
+	ring := []postgis.Coord{{X: 0, Y: 0, Z: 1}, {X: 4, Y: 0, Z: 1}, {X: 4, Y: 4, Z: 1}, {X: 0, Y: 0, Z: 1}}
+	values := []postgis.Geometry{
+		{Geom: point(1, 2), SRID: 4326, Status: pgtype.Present},
+		{Geom: postgis.Geom{Type: postgis.PointType}, Status: pgtype.Present},
+		{Geom: postgis.Geom{Type: postgis.PolygonType, Rings: [][]postgis.Coord{ring}}, HasZ: true, Status: pgtype.Present},
+		{
+			Geom: postgis.Geom{Type: postgis.GeometryCollectionType, Geoms: []postgis.Geom{
+				point(1, 2),
+				{Type: postgis.LineStringType, Coords: []postgis.Coord{{X: 0, Y: 0}, {X: 1, Y: 1}}},
+			}},
+			SRID:   3857,
+			Status: pgtype.Present,
+		},
+		{Status: pgtype.Null},
+	}
+
+	for i, v := range values {
+		for _, formatCode := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+			var result postgis.Geometry
+			err := conn.QueryRow(context.Background(), "select $1::geometry", testutil.ForceEncoder(v, formatCode)).Scan(&result)
+			require.NoErrorf(t, err, "%d %d", i, formatCode)
+			assert.Equalf(t, v, result, "%d %d", i, formatCode)
+		}
+	}
+
+	var text string
+	err = conn.QueryRow(context.Background(), "select st_asewkt($1::geometry)", values[0]).Scan(&text)
+	require.NoError(t, err)
+	assert.Equal(t, "SRID=4326;POINT(1 2)", text)
+
+	var geog postgis.Geography
+	err = conn.QueryRow(context.Background(), "select 'POINT(1 2)'::geography").Scan(&geog)
+	require.NoError(t, err)
+	assert.Equal(t, postgis.Geography{Geom: point(1, 2), SRID: 4326, Status: pgtype.Present}, geog)
+
+	geoms := []postgis.Geometry{{Geom: point(1, 2), Status: pgtype.Present}, {Geom: point(3, 4), SRID: 4326, Status: pgtype.Present}}
+	dt, ok := conn.ConnInfo().DataTypeForName("_geometry")
+	require.True(t, ok)
+	arg := pgtype.NewValue(dt.Value)
+	require.NoError(t, arg.Set(geoms))
+
+	for _, formatCode := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+		var result []postgis.Geometry
+		err = conn.QueryRow(context.Background(), "select array['POINT(1 2)'::geometry, 'SRID=4326;POINT(3 4)'::geometry]", pgx.QueryResultFormats{formatCode}).Scan(&result)
+		require.NoErrorf(t, err, "%d", formatCode)
+		assert.Equalf(t, geoms, result, "%d", formatCode)
+
+		result = nil
+		err = conn.QueryRow(context.Background(), "select $1::geometry[]", pgx.QueryResultFormats{formatCode}, testutil.ForceEncoder(arg, formatCode)).Scan(&result)
+		require.NoErrorf(t, err, "%d", formatCode)
+		assert.Equalf(t, geoms, result, "%d", formatCode)
+
+		err = conn.QueryRow(context.Background(), "select st_asewkt(($1::geometry[])[2])", testutil.ForceEncoder(arg, formatCode)).Scan(&text)
+		require.NoErrorf(t, err, "%d", formatCode)
+		assert.Equalf(t, "SRID=4326;POINT(3 4)", text, "%d", formatCode)
+	}
+}
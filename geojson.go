@@ -0,0 +1,453 @@
+package pgtype
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// GeoJSON encoding is opt-in. The MarshalJSON and UnmarshalJSON methods of the geometric types are unchanged. Use the
+// MarshalGeoJSON and UnmarshalGeoJSON methods directly or wrap a value in GeoJSON to use GeoJSON with encoding/json.
+//
+// Point is encoded as a Point, an open Path and Lseg as a LineString, and a closed Path, Polygon and Box as a Polygon
+// with a single ring. Vertex order is preserved and rings are closed by repeating the first position. A LineString needs
+// at least 2 points and a Polygon at least 3, so encoding a Path or Polygon with fewer is an error. Circle is encoded
+// as a Feature with a Point geometry and a radius property, or with MarshalGeoJSONPolygon as an approximated Polygon.
+//
+// Decoding also accepts a Feature containing a matching geometry. Positions with more than two elements are accepted
+// and the extra elements are discarded.
+
+// GeoJSONMarshaler is implemented by types that can encode themselves as GeoJSON.
+type GeoJSONMarshaler interface {
+	MarshalGeoJSON() ([]byte, error)
+}
+
+// GeoJSONUnmarshaler is implemented by types that can decode themselves from GeoJSON.
+type GeoJSONUnmarshaler interface {
+	UnmarshalGeoJSON(data []byte) error
+}
+
+// GeoJSON wraps a value so that encoding/json encodes and decodes it as GeoJSON. Value must implement
+// GeoJSONMarshaler to be marshaled and GeoJSONUnmarshaler to be unmarshaled. e.g. json.Unmarshal(data,
+// &pgtype.GeoJSON{Value: &point}).
+type GeoJSON struct {
+	Value interface{}
+}
+
+func (src GeoJSON) MarshalJSON() ([]byte, error) {
+	m, ok := src.Value.(GeoJSONMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("cannot encode %T as GeoJSON", src.Value)
+	}
+	return m.MarshalGeoJSON()
+}
+
+func (dst *GeoJSON) UnmarshalJSON(data []byte) error {
+	u, ok := dst.Value.(GeoJSONUnmarshaler)
+	if !ok {
+		return fmt.Errorf("cannot decode GeoJSON into %T", dst.Value)
+	}
+	return u.UnmarshalGeoJSON(data)
+}
+
+type geoJSONGeometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+type geoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   geoJSONGeometry        `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// geoJSONObject is a decoded GeoJSON geometry or Feature.
+type geoJSONObject struct {
+	Type        string                     `json:"type"`
+	Coordinates json.RawMessage            `json:"coordinates"`
+	Geometry    *geoJSONObject             `json:"geometry"`
+	Properties  map[string]json.RawMessage `json:"properties"`
+}
+
+func marshalGeoJSON(status Status, v interface{}) ([]byte, error) {
+	switch status {
+	case Present:
+		return json.Marshal(v)
+	case Null:
+		return []byte("null"), nil
+	case Undefined:
+		return nil, errUndefined
+	}
+	return nil, errBadStatus
+}
+
+func geoJSONPosition(p Vec2) [2]float64 {
+	return [2]float64{p.X, p.Y}
+}
+
+func geoJSONPositions(points []Vec2) [][2]float64 {
+	coordinates := make([][2]float64, len(points))
+	for i, p := range points {
+		coordinates[i] = geoJSONPosition(p)
+	}
+	return coordinates
+}
+
+// geoJSONLineString returns the coordinates of a LineString through points. GeoJSON requires at least 2 positions.
+func geoJSONLineString(points []Vec2) ([][2]float64, error) {
+	if len(points) < 2 {
+		return nil, fmt.Errorf("cannot encode %d points as a GeoJSON LineString, at least 2 are required", len(points))
+	}
+	return geoJSONPositions(points), nil
+}
+
+// geoJSONPolygon returns the coordinates of a Polygon with a single ring through points. GeoJSON requires a ring to
+// have at least 4 positions so fewer than 3 points are an error.
+func geoJSONPolygon(points []Vec2) ([][][2]float64, error) {
+	if len(points) < 3 {
+		return nil, fmt.Errorf("cannot encode %d points as a GeoJSON Polygon, at least 3 are required", len(points))
+	}
+
+	ring := make([][2]float64, 0, len(points)+1)
+	ring = append(ring, geoJSONPositions(points)...)
+	ring = append(ring, geoJSONPosition(points[0]))
+	return [][][2]float64{ring}, nil
+}
+
+// unmarshalGeoJSON decodes data into a geometry. It returns nil for null. A Feature is replaced by its geometry and its
+// properties are kept.
+func unmarshalGeoJSON(data []byte) (*geoJSONObject, error) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil, nil
+	}
+
+	var obj geoJSONObject
+	err := json.Unmarshal(data, &obj)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.Type == "Feature" {
+		if obj.Geometry == nil {
+			return nil, errors.New("GeoJSON Feature has no geometry")
+		}
+		geometry := *obj.Geometry
+		geometry.Properties = obj.Properties
+		return &geometry, nil
+	}
+
+	return &obj, nil
+}
+
+func (obj *geoJSONObject) point() (Vec2, error) {
+	var position []float64
+	err := json.Unmarshal(obj.Coordinates, &position)
+	if err != nil {
+		return Vec2{}, fmt.Errorf("invalid GeoJSON %s coordinates: %w", obj.Type, err)
+	}
+	return geoJSONVec2(position)
+}
+
+func (obj *geoJSONObject) lineString() ([]Vec2, error) {
+	var positions [][]float64
+	err := json.Unmarshal(obj.Coordinates, &positions)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON %s coordinates: %w", obj.Type, err)
+	}
+	if len(positions) < 2 {
+		return nil, fmt.Errorf("GeoJSON %s must have at least 2 positions", obj.Type)
+	}
+	return geoJSONVec2s(positions)
+}
+
+// polygon returns the vertices of the exterior ring without the closing position. Holes cannot be represented and are
+// an error.
+func (obj *geoJSONObject) polygon() ([]Vec2, error) {
+	var rings [][][]float64
+	err := json.Unmarshal(obj.Coordinates, &rings)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON %s coordinates: %w", obj.Type, err)
+	}
+	if len(rings) != 1 {
+		return nil, fmt.Errorf("GeoJSON Polygon must have exactly 1 ring, has %d", len(rings))
+	}
+	if len(rings[0]) < 4 {
+		return nil, errors.New("GeoJSON Polygon ring must have at least 4 positions")
+	}
+
+	points, err := geoJSONVec2s(rings[0])
+	if err != nil {
+		return nil, err
+	}
+	if points[0] != points[len(points)-1] {
+		return nil, errors.New("GeoJSON Polygon ring is not closed")
+	}
+	return points[:len(points)-1], nil
+}
+
+func geoJSONVec2(position []float64) (Vec2, error) {
+	if len(position) < 2 {
+		return Vec2{}, fmt.Errorf("GeoJSON position must have at least 2 elements, has %d", len(position))
+	}
+	return Vec2{X: position[0], Y: position[1]}, nil
+}
+
+func geoJSONVec2s(positions [][]float64) ([]Vec2, error) {
+	points := make([]Vec2, len(positions))
+	for i, position := range positions {
+		p, err := geoJSONVec2(position)
+		if err != nil {
+			return nil, err
+		}
+		points[i] = p
+	}
+	return points, nil
+}
+
+func errGeoJSONType(obj *geoJSONObject, dst interface{}) error {
+	return fmt.Errorf("cannot decode GeoJSON %s into %T", obj.Type, dst)
+}
+
+// MarshalGeoJSON encodes src as a GeoJSON Point.
+func (src Point) MarshalGeoJSON() ([]byte, error) {
+	return marshalGeoJSON(src.Status, geoJSONGeometry{Type: "Point", Coordinates: geoJSONPosition(src.P)})
+}
+
+// UnmarshalGeoJSON decodes a GeoJSON Point into dst.
+func (dst *Point) UnmarshalGeoJSON(data []byte) error {
+	obj, err := unmarshalGeoJSON(data)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		*dst = Point{Status: Null}
+		return nil
+	}
+	if obj.Type != "Point" {
+		return errGeoJSONType(obj, dst)
+	}
+
+	p, err := obj.point()
+	if err != nil {
+		return err
+	}
+	*dst = Point{P: p, Status: Present}
+	return nil
+}
+
+// MarshalGeoJSON encodes src as a GeoJSON LineString.
+func (src Lseg) MarshalGeoJSON() ([]byte, error) {
+	return marshalGeoJSON(src.Status, geoJSONGeometry{Type: "LineString", Coordinates: geoJSONPositions(src.P[:])})
+}
+
+// UnmarshalGeoJSON decodes a GeoJSON LineString with 2 positions into dst.
+func (dst *Lseg) UnmarshalGeoJSON(data []byte) error {
+	obj, err := unmarshalGeoJSON(data)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		*dst = Lseg{Status: Null}
+		return nil
+	}
+	if obj.Type != "LineString" {
+		return errGeoJSONType(obj, dst)
+	}
+
+	points, err := obj.lineString()
+	if err != nil {
+		return err
+	}
+	if len(points) != 2 {
+		return fmt.Errorf("GeoJSON LineString must have 2 positions to decode into Lseg, has %d", len(points))
+	}
+	*dst = Lseg{P: [2]Vec2{points[0], points[1]}, Status: Present}
+	return nil
+}
+
+// MarshalGeoJSON encodes src as a GeoJSON LineString if it is open and as a Polygon if it is closed.
+func (src Path) MarshalGeoJSON() ([]byte, error) {
+	if src.Status != Present {
+		return marshalGeoJSON(src.Status, nil)
+	}
+
+	if src.Closed {
+		coordinates, err := geoJSONPolygon(src.P)
+		if err != nil {
+			return nil, err
+		}
+		return marshalGeoJSON(src.Status, geoJSONGeometry{Type: "Polygon", Coordinates: coordinates})
+	}
+
+	coordinates, err := geoJSONLineString(src.P)
+	if err != nil {
+		return nil, err
+	}
+	return marshalGeoJSON(src.Status, geoJSONGeometry{Type: "LineString", Coordinates: coordinates})
+}
+
+// UnmarshalGeoJSON decodes a GeoJSON LineString into an open path or a Polygon into a closed path.
+func (dst *Path) UnmarshalGeoJSON(data []byte) error {
+	obj, err := unmarshalGeoJSON(data)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		*dst = Path{Status: Null}
+		return nil
+	}
+
+	var points []Vec2
+	var closed bool
+	switch obj.Type {
+	case "LineString":
+		points, err = obj.lineString()
+	case "Polygon":
+		points, err = obj.polygon()
+		closed = true
+	default:
+		return errGeoJSONType(obj, dst)
+	}
+	if err != nil {
+		return err
+	}
+
+	*dst = Path{P: points, Closed: closed, Status: Present}
+	return nil
+}
+
+// MarshalGeoJSON encodes src as a GeoJSON Polygon.
+func (src Polygon) MarshalGeoJSON() ([]byte, error) {
+	if src.Status != Present {
+		return marshalGeoJSON(src.Status, nil)
+	}
+
+	coordinates, err := geoJSONPolygon(src.P)
+	if err != nil {
+		return nil, err
+	}
+	return marshalGeoJSON(src.Status, geoJSONGeometry{Type: "Polygon", Coordinates: coordinates})
+}
+
+// UnmarshalGeoJSON decodes a GeoJSON Polygon without holes into dst.
+func (dst *Polygon) UnmarshalGeoJSON(data []byte) error {
+	obj, err := unmarshalGeoJSON(data)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		*dst = Polygon{Status: Null}
+		return nil
+	}
+	if obj.Type != "Polygon" {
+		return errGeoJSONType(obj, dst)
+	}
+
+	points, err := obj.polygon()
+	if err != nil {
+		return err
+	}
+	*dst = Polygon{P: points, Status: Present}
+	return nil
+}
+
+// MarshalGeoJSON encodes src as a GeoJSON Polygon starting at the lower left corner and running counterclockwise.
+func (src Box) MarshalGeoJSON() ([]byte, error) {
+	if src.Status != Present {
+		return marshalGeoJSON(src.Status, nil)
+	}
+
+	coordinates, err := geoJSONPolygon(src.rectangle())
+	if err != nil {
+		return nil, err
+	}
+	return marshalGeoJSON(src.Status, geoJSONGeometry{Type: "Polygon", Coordinates: coordinates})
+}
+
+// UnmarshalGeoJSON decodes a GeoJSON Polygon into dst. The polygon must have 4 vertices at the corners of an
+// axis-aligned rectangle.
+func (dst *Box) UnmarshalGeoJSON(data []byte) error {
+	obj, err := unmarshalGeoJSON(data)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		*dst = Box{Status: Null}
+		return nil
+	}
+	if obj.Type != "Polygon" {
+		return errGeoJSONType(obj, dst)
+	}
+
+	points, err := obj.polygon()
+	if err != nil {
+		return err
+	}
+	box, ok := boxFromRectangle(points)
+	if !ok {
+		return errors.New("GeoJSON Polygon is not an axis-aligned rectangle")
+	}
+
+	*dst = box
+	return nil
+}
+
+// MarshalGeoJSON encodes src as a GeoJSON Feature with a Point geometry at the center and a radius property.
+func (src Circle) MarshalGeoJSON() ([]byte, error) {
+	return marshalGeoJSON(src.Status, geoJSONFeature{
+		Type:       "Feature",
+		Geometry:   geoJSONGeometry{Type: "Point", Coordinates: geoJSONPosition(src.P)},
+		Properties: map[string]interface{}{"radius": src.R},
+	})
+}
+
+// MarshalGeoJSONPolygon encodes src as a GeoJSON Polygon with n vertices as computed by Circle.Polygon.
+func (src Circle) MarshalGeoJSONPolygon(n int) ([]byte, error) {
+	if src.Status != Present {
+		return marshalGeoJSON(src.Status, nil)
+	}
+
+	polygon, err := src.Polygon(n)
+	if err != nil {
+		return nil, err
+	}
+	return polygon.MarshalGeoJSON()
+}
+
+// UnmarshalGeoJSON decodes a GeoJSON Feature with a Point geometry and a non-negative radius property into dst.
+func (dst *Circle) UnmarshalGeoJSON(data []byte) error {
+	obj, err := unmarshalGeoJSON(data)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		*dst = Circle{Status: Null}
+		return nil
+	}
+	if obj.Type != "Point" {
+		return errGeoJSONType(obj, dst)
+	}
+
+	p, err := obj.point()
+	if err != nil {
+		return err
+	}
+
+	rawRadius, ok := obj.Properties["radius"]
+	if !ok {
+		return errors.New("GeoJSON Point has no radius property")
+	}
+	var r float64
+	err = json.Unmarshal(rawRadius, &r)
+	if err != nil {
+		return fmt.Errorf("invalid GeoJSON radius property: %w", err)
+	}
+	if r < 0 || math.IsNaN(r) {
+		return fmt.Errorf("invalid GeoJSON radius %v", r)
+	}
+
+	*dst = Circle{P: p, R: r, Status: Present}
+	return nil
+}
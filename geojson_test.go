@@ -0,0 +1,236 @@
+package pgtype_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoJSONMarshal(t *testing.T) {
+	tests := []struct {
+		src    pgtype.GeoJSONMarshaler
+		result string
+	}{
+		{
+			src:    pgtype.Point{P: pgtype.Vec2{X: 1.5, Y: -2}, Status: pgtype.Present},
+			result: `{"type":"Point","coordinates":[1.5,-2]}`,
+		},
+		{
+			src:    pgtype.Lseg{P: [2]pgtype.Vec2{{X: 1, Y: 2}, {X: 3, Y: 4}}, Status: pgtype.Present},
+			result: `{"type":"LineString","coordinates":[[1,2],[3,4]]}`,
+		},
+		{
+			src:    pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Status: pgtype.Present},
+			result: `{"type":"LineString","coordinates":[[0,0],[1,0],[1,1]]}`,
+		},
+		{
+			src:    pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Closed: true, Status: pgtype.Present},
+			result: `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`,
+		},
+		{
+			src:    pgtype.Polygon{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Status: pgtype.Present},
+			result: `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`,
+		},
+		{
+			src:    pgtype.Box{P: [2]pgtype.Vec2{{X: 3, Y: 4}, {X: 1, Y: 2}}, Status: pgtype.Present},
+			result: `{"type":"Polygon","coordinates":[[[1,2],[3,2],[3,4],[1,4],[1,2]]]}`,
+		},
+		{
+			src:    pgtype.Circle{P: pgtype.Vec2{X: 1, Y: 2}, R: 3, Status: pgtype.Present},
+			result: `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"radius":3}}`,
+		},
+		{src: pgtype.Point{Status: pgtype.Null}, result: `null`},
+		{src: pgtype.Circle{Status: pgtype.Null}, result: `null`},
+	}
+
+	for i, tt := range tests {
+		buf, err := tt.src.MarshalGeoJSON()
+		require.NoErrorf(t, err, "%d", i)
+		assert.Equalf(t, tt.result, string(buf), "%d", i)
+	}
+
+	_, err := pgtype.Point{}.MarshalGeoJSON()
+	require.Error(t, err)
+	_, err = pgtype.Point{P: pgtype.Vec2{X: math.NaN()}, Status: pgtype.Present}.MarshalGeoJSON()
+	require.Error(t, err)
+}
+
+func TestGeoJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		src pgtype.GeoJSONMarshaler
+		dst pgtype.GeoJSONUnmarshaler
+	}{
+		{src: &pgtype.Point{P: pgtype.Vec2{X: 1.5, Y: -2}, Status: pgtype.Present}, dst: &pgtype.Point{}},
+		{src: &pgtype.Lseg{P: [2]pgtype.Vec2{{X: 1, Y: 2}, {X: 3, Y: 4}}, Status: pgtype.Present}, dst: &pgtype.Lseg{}},
+		{src: &pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}}, Status: pgtype.Present}, dst: &pgtype.Path{}},
+		{src: &pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Closed: true, Status: pgtype.Present}, dst: &pgtype.Path{}},
+		{src: &pgtype.Polygon{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Status: pgtype.Present}, dst: &pgtype.Polygon{}},
+		{src: &pgtype.Box{P: [2]pgtype.Vec2{{X: 3, Y: 4}, {X: 1, Y: 2}}, Status: pgtype.Present}, dst: &pgtype.Box{}},
+		{src: &pgtype.Box{P: [2]pgtype.Vec2{{X: 1, Y: 2}, {X: 1, Y: 2}}, Status: pgtype.Present}, dst: &pgtype.Box{}},
+		{src: &pgtype.Circle{P: pgtype.Vec2{X: 1, Y: 2}, R: 3, Status: pgtype.Present}, dst: &pgtype.Circle{}},
+		{src: &pgtype.Polygon{Status: pgtype.Null}, dst: &pgtype.Polygon{}},
+	}
+
+	for i, tt := range tests {
+		buf, err := tt.src.MarshalGeoJSON()
+		require.NoErrorf(t, err, "%d", i)
+		err = tt.dst.UnmarshalGeoJSON(buf)
+		require.NoErrorf(t, err, "%d: %s", i, buf)
+		assert.Equalf(t, tt.src, tt.dst, "%d", i)
+	}
+
+	// Geometries GeoJSON cannot represent are rejected instead of being encoded as something that does not decode.
+	for i, src := range []pgtype.GeoJSONMarshaler{
+		pgtype.Polygon{Status: pgtype.Present},
+		pgtype.Polygon{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}}, Status: pgtype.Present},
+		pgtype.Path{Closed: true, Status: pgtype.Present},
+		pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}}, Closed: true, Status: pgtype.Present},
+		pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}}, Status: pgtype.Present},
+	} {
+		_, err := src.MarshalGeoJSON()
+		assert.Errorf(t, err, "%d", i)
+	}
+}
+
+func TestGeoJSONUnmarshal(t *testing.T) {
+	tests := []struct {
+		src    string
+		dst    pgtype.GeoJSONUnmarshaler
+		result interface{}
+	}{
+		{
+			src:    `{"type":"Point","coordinates":[1.5,-2]}`,
+			dst:    &pgtype.Point{},
+			result: &pgtype.Point{P: pgtype.Vec2{X: 1.5, Y: -2}, Status: pgtype.Present},
+		},
+		{
+			src:    `{"type":"Point","coordinates":[1.5,-2,10]}`,
+			dst:    &pgtype.Point{},
+			result: &pgtype.Point{P: pgtype.Vec2{X: 1.5, Y: -2}, Status: pgtype.Present},
+		},
+		{
+			src:    `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":null}`,
+			dst:    &pgtype.Point{},
+			result: &pgtype.Point{P: pgtype.Vec2{X: 1, Y: 2}, Status: pgtype.Present},
+		},
+		{
+			src:    `null`,
+			dst:    &pgtype.Point{},
+			result: &pgtype.Point{Status: pgtype.Null},
+		},
+		{
+			src:    `{"type":"LineString","coordinates":[[1,2],[3,4]]}`,
+			dst:    &pgtype.Lseg{},
+			result: &pgtype.Lseg{P: [2]pgtype.Vec2{{X: 1, Y: 2}, {X: 3, Y: 4}}, Status: pgtype.Present},
+		},
+		{
+			src:    `{"type":"LineString","coordinates":[[0,0],[1,0],[1,1]]}`,
+			dst:    &pgtype.Path{},
+			result: &pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Status: pgtype.Present},
+		},
+		{
+			src:    `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`,
+			dst:    &pgtype.Path{},
+			result: &pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Closed: true, Status: pgtype.Present},
+		},
+		{
+			src:    `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`,
+			dst:    &pgtype.Polygon{},
+			result: &pgtype.Polygon{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Status: pgtype.Present},
+		},
+		{
+			src:    `{"type":"Polygon","coordinates":[[[3,4],[1,4],[1,2],[3,2],[3,4]]]}`,
+			dst:    &pgtype.Box{},
+			result: &pgtype.Box{P: [2]pgtype.Vec2{{X: 3, Y: 4}, {X: 1, Y: 2}}, Status: pgtype.Present},
+		},
+		{
+			src:    `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"radius":3}}`,
+			dst:    &pgtype.Circle{},
+			result: &pgtype.Circle{P: pgtype.Vec2{X: 1, Y: 2}, R: 3, Status: pgtype.Present},
+		},
+	}
+
+	for i, tt := range tests {
+		err := tt.dst.UnmarshalGeoJSON([]byte(tt.src))
+		require.NoErrorf(t, err, "%d", i)
+		assert.Equalf(t, tt.result, tt.dst, "%d", i)
+	}
+
+	errorTests := []struct {
+		src string
+		dst pgtype.GeoJSONUnmarshaler
+	}{
+		{src: `{"type":"LineString","coordinates":[[1,2],[3,4]]}`, dst: &pgtype.Point{}},
+		{src: `{"type":"Point","coordinates":[1]}`, dst: &pgtype.Point{}},
+		{src: `{"type":"Feature","properties":{}}`, dst: &pgtype.Point{}},
+		{src: `{"type":"LineString","coordinates":[[1,2],[3,4],[5,6]]}`, dst: &pgtype.Lseg{}},
+		{src: `{"type":"LineString","coordinates":[[1,2]]}`, dst: &pgtype.Path{}},
+		{src: `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`, dst: &pgtype.Polygon{}},
+		{src: `{"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0]]]}`, dst: &pgtype.Polygon{}},
+		{src: `{"type":"Polygon","coordinates":[[[0,0],[4,0],[4,4],[0,0]],[[1,1],[2,1],[2,2],[1,1]]]}`, dst: &pgtype.Polygon{}},
+		{src: `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`, dst: &pgtype.Box{}},
+		{src: `{"type":"Point","coordinates":[1,2]}`, dst: &pgtype.Circle{}},
+		{src: `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"radius":-1}}`, dst: &pgtype.Circle{}},
+	}
+
+	for i, tt := range errorTests {
+		err := tt.dst.UnmarshalGeoJSON([]byte(tt.src))
+		require.Errorf(t, err, "%d", i)
+	}
+}
+
+func TestCircleMarshalGeoJSONPolygon(t *testing.T) {
+	circle := pgtype.Circle{P: pgtype.Vec2{X: 1, Y: 1}, R: 2, Status: pgtype.Present}
+
+	buf, err := circle.MarshalGeoJSONPolygon(4)
+	require.NoError(t, err)
+
+	var polygon pgtype.Polygon
+	err = polygon.UnmarshalGeoJSON(buf)
+	require.NoError(t, err)
+	require.Len(t, polygon.P, 4)
+
+	expected := []pgtype.Vec2{{X: -1, Y: 1}, {X: 1, Y: 3}, {X: 3, Y: 1}, {X: 1, Y: -1}}
+	for i := range expected {
+		assert.InDeltaf(t, expected[i].X, polygon.P[i].X, 1e-9, "%d", i)
+		assert.InDeltaf(t, expected[i].Y, polygon.P[i].Y, 1e-9, "%d", i)
+	}
+
+	_, err = circle.MarshalGeoJSONPolygon(1)
+	require.Error(t, err)
+
+	buf, err = pgtype.Circle{Status: pgtype.Null}.MarshalGeoJSONPolygon(4)
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(buf))
+}
+
+func TestGeoJSONWrapper(t *testing.T) {
+	type location struct {
+		Name  string         `json:"name"`
+		Point pgtype.GeoJSON `json:"point"`
+	}
+
+	buf, err := json.Marshal(location{Name: "a", Point: pgtype.GeoJSON{Value: pgtype.Point{P: pgtype.Vec2{X: 1, Y: 2}, Status: pgtype.Present}}})
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"a","point":{"type":"Point","coordinates":[1,2]}}`, string(buf))
+
+	var point pgtype.Point
+	loc := location{Point: pgtype.GeoJSON{Value: &point}}
+	err = json.Unmarshal(buf, &loc)
+	require.NoError(t, err)
+	assert.Equal(t, pgtype.Point{P: pgtype.Vec2{X: 1, Y: 2}, Status: pgtype.Present}, point)
+
+	// The geometric types keep their own JSON encoding.
+	buf, err = json.Marshal(point)
+	require.NoError(t, err)
+	assert.Equal(t, `"(1,2)"`, string(buf))
+
+	_, err = json.Marshal(pgtype.GeoJSON{Value: 1})
+	require.Error(t, err)
+	err = json.Unmarshal([]byte(`{"type":"Point","coordinates":[1,2]}`), &pgtype.GeoJSON{Value: point})
+	require.Error(t, err)
+}
@@ -0,0 +1,722 @@
+package pgtype
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// The geometric methods follow src/backend/utils/adt/geo_ops.c so results match the PostgreSQL operators noted on each
+// method. Like PostgreSQL, many comparisons allow a small tolerance of geometricEpsilon. The methods operate on the
+// coordinates and do not check Status.
+
+const geometricEpsilon = 1.0e-06
+
+func fpZero(a float64) bool  { return math.Abs(a) <= geometricEpsilon }
+func fpEq(a, b float64) bool { return a == b || math.Abs(a-b) <= geometricEpsilon }
+func fpLt(a, b float64) bool { return a+geometricEpsilon < b }
+func fpLe(a, b float64) bool { return a <= b+geometricEpsilon }
+func fpGt(a, b float64) bool { return a > b+geometricEpsilon }
+func fpGe(a, b float64) bool { return a+geometricEpsilon >= b }
+
+func newPoint(v Vec2) Point {
+	return Point{P: v, Status: Present}
+}
+
+// pointDistance returns the distance between a and b.
+func pointDistance(a, b Vec2) float64 {
+	return math.Hypot(a.X-b.X, a.Y-b.Y)
+}
+
+// pointSlope returns the slope of the line through a and b.
+func pointSlope(a, b Vec2) float64 {
+	if fpEq(a.X, b.X) {
+		return math.Inf(1)
+	}
+	if fpEq(a.Y, b.Y) {
+		return 0
+	}
+	return (a.Y - b.Y) / (a.X - b.X)
+}
+
+// pointInverseSlope returns the slope of a line perpendicular to the line through a and b.
+func pointInverseSlope(a, b Vec2) float64 {
+	if fpEq(a.X, b.X) {
+		return 0
+	}
+	if fpEq(a.Y, b.Y) {
+		return math.Inf(1)
+	}
+	return (a.X - b.X) / (b.Y - a.Y)
+}
+
+// newLineFromSlope returns the line through p with slope m.
+func newLineFromSlope(p Vec2, m float64) Line {
+	switch {
+	case math.IsInf(m, 0):
+		return Line{A: -1, B: 0, C: p.X, Status: Present}
+	case m == 0:
+		return Line{A: 0, B: -1, C: p.Y, Status: Present}
+	default:
+		c := p.Y - m*p.X
+		if c == 0 {
+			c = 0 // Avoid -0.
+		}
+		return Line{A: m, B: -1, C: c, Status: Present}
+	}
+}
+
+// NewLineFromPoints returns the line through p1 and p2 like the PostgreSQL line(point, point) function.
+func NewLineFromPoints(p1, p2 Vec2) (Line, error) {
+	if fpEq(p1.X, p2.X) && fpEq(p1.Y, p2.Y) {
+		return Line{}, errors.New("invalid line specification: must be two distinct points")
+	}
+	return newLineFromSlope(p1, pointSlope(p1, p2)), nil
+}
+
+// Points returns two distinct points on src. It is an error if A and B are both zero.
+func (src Line) Points() (Vec2, Vec2, error) {
+	switch {
+	case src.B != 0:
+		return Vec2{X: 0, Y: -src.C / src.B}, Vec2{X: 1, Y: -(src.A + src.C) / src.B}, nil
+	case src.A != 0:
+		x := -src.C / src.A
+		return Vec2{X: x, Y: 0}, Vec2{X: x, Y: 1}, nil
+	default:
+		return Vec2{}, Vec2{}, errors.New("invalid line specification: A and B cannot both be zero")
+	}
+}
+
+// Intersection returns the point where src and l intersect like the PostgreSQL # operator. It returns false if the
+// lines are parallel.
+func (src Line) Intersection(l Line) (Point, bool) {
+	var x, y float64
+	switch {
+	case !fpZero(src.B):
+		if fpEq(l.A, src.A*(l.B/src.B)) {
+			return Point{}, false
+		}
+		x = (src.B*l.C - l.B*src.C) / (src.A*l.B - l.A*src.B)
+		y = -(src.A*x + src.C) / src.B
+	case !fpZero(l.B):
+		if fpEq(src.A, l.A*(src.B/l.B)) {
+			return Point{}, false
+		}
+		x = (l.B*src.C - src.B*l.C) / (l.A*src.B - src.A*l.B)
+		y = -(l.A*x + l.C) / l.B
+	default:
+		return Point{}, false
+	}
+
+	// Avoid -0.
+	if x == 0 {
+		x = 0
+	}
+	if y == 0 {
+		y = 0
+	}
+	return newPoint(Vec2{X: x, Y: y}), true
+}
+
+// Intersects reports whether src and l intersect like the PostgreSQL ?# operator.
+func (src Line) Intersects(l Line) bool {
+	_, ok := src.Intersection(l)
+	return ok
+}
+
+// ClosestPoint returns the point on src closest to p like the PostgreSQL ## operator.
+func (src Line) ClosestPoint(p Point) Point {
+	closest, _ := src.closestPoint(p.P)
+	return newPoint(closest)
+}
+
+func (src Line) closestPoint(p Vec2) (Vec2, float64) {
+	// Intersect with the perpendicular line through p.
+	tmp := Line{A: src.B, B: -src.A, C: src.A*p.Y - src.B*p.X}
+	closest, ok := src.Intersection(tmp)
+	if !ok {
+		// src is not a valid line.
+		return p, math.NaN()
+	}
+	return closest.P, pointDistance(closest.P, p)
+}
+
+// Distance returns the distance between src and p like the PostgreSQL <-> operator.
+func (src Point) Distance(p Point) float64 {
+	return pointDistance(src.P, p.P)
+}
+
+// DistanceToLine returns the distance between src and l like the PostgreSQL <-> operator.
+func (src Point) DistanceToLine(l Line) float64 {
+	_, d := l.closestPoint(src.P)
+	return d
+}
+
+// DistanceToLseg returns the distance between src and l like the PostgreSQL <-> operator.
+func (src Point) DistanceToLseg(l Lseg) float64 {
+	_, d := l.closestPoint(src.P)
+	return d
+}
+
+// DistanceToBox returns the distance between src and b like the PostgreSQL <-> operator.
+func (src Point) DistanceToBox(b Box) float64 {
+	_, d := b.closestPoint(src.P)
+	return d
+}
+
+// DistanceToPath returns the distance between src and the segments of p like the PostgreSQL <-> operator.
+func (src Point) DistanceToPath(p Path) float64 {
+	if len(p.P) == 1 {
+		return pointDistance(src.P, p.P[0])
+	}
+
+	d := math.Inf(1)
+	for _, seg := range pathSegments(p.P, p.Closed) {
+		if _, sd := seg.closestPoint(src.P); sd < d {
+			d = sd
+		}
+	}
+	return d
+}
+
+// DistanceToPolygon returns the distance between src and p like the PostgreSQL <-> operator. It is 0 if p contains
+// src.
+func (src Point) DistanceToPolygon(p Polygon) float64 {
+	if p.ContainsPoint(src) {
+		return 0
+	}
+	return src.DistanceToPath(Path{P: p.P, Closed: true})
+}
+
+// DistanceToCircle returns the distance between src and c like the PostgreSQL <-> operator. It is 0 if c contains
+// src.
+func (src Point) DistanceToCircle(c Circle) float64 {
+	return math.Max(pointDistance(src.P, c.P)-c.R, 0)
+}
+
+// pathSegments returns the segments between consecutive points including the segment from the last point to the
+// first if closed.
+func pathSegments(points []Vec2, closed bool) []Lseg {
+	if len(points) < 2 {
+		return nil
+	}
+
+	segs := make([]Lseg, 0, len(points))
+	for i := 1; i < len(points); i++ {
+		segs = append(segs, Lseg{P: [2]Vec2{points[i-1], points[i]}, Status: Present})
+	}
+	if closed {
+		segs = append(segs, Lseg{P: [2]Vec2{points[len(points)-1], points[0]}, Status: Present})
+	}
+	return segs
+}
+
+// Length returns the length of src like the PostgreSQL length function.
+func (src Lseg) Length() float64 {
+	return pointDistance(src.P[0], src.P[1])
+}
+
+// Center returns the midpoint of src like the PostgreSQL @@ operator.
+func (src Lseg) Center() Point {
+	return newPoint(Vec2{X: (src.P[0].X + src.P[1].X) / 2, Y: (src.P[0].Y + src.P[1].Y) / 2})
+}
+
+// Line returns the line through the end points of src like the PostgreSQL line(point, point) function.
+func (src Lseg) Line() (Line, error) {
+	return NewLineFromPoints(src.P[0], src.P[1])
+}
+
+func (src Lseg) slopeLine() Line {
+	return newLineFromSlope(src.P[0], pointSlope(src.P[0], src.P[1]))
+}
+
+// containsPoint reports whether p is on src within tolerance.
+func (src Lseg) containsPoint(p Vec2) bool {
+	return fpEq(pointDistance(p, src.P[0])+pointDistance(p, src.P[1]), src.Length())
+}
+
+// IntersectionWithLine returns the point where src and l intersect like the PostgreSQL # operator. It returns false
+// if they do not intersect or are parallel.
+func (src Lseg) IntersectionWithLine(l Line) (Point, bool) {
+	p, ok := src.slopeLine().Intersection(l)
+	if !ok || !src.containsPoint(p.P) {
+		return Point{}, false
+	}
+	return p, true
+}
+
+// IntersectsLine reports whether src and l intersect like the PostgreSQL ?# operator.
+func (src Lseg) IntersectsLine(l Line) bool {
+	_, ok := src.IntersectionWithLine(l)
+	return ok
+}
+
+// Intersection returns the point where src and l intersect like the PostgreSQL # operator. It returns false if they
+// do not intersect or are parallel. Like PostgreSQL, collinear segments do not have an intersection point.
+func (src Lseg) Intersection(l Lseg) (Point, bool) {
+	p, ok := src.IntersectionWithLine(l.slopeLine())
+	if !ok || !l.containsPoint(p.P) {
+		return Point{}, false
+	}
+	return p, true
+}
+
+// Intersects reports whether src and l intersect like the PostgreSQL ?# operator.
+func (src Lseg) Intersects(l Lseg) bool {
+	_, ok := src.Intersection(l)
+	return ok
+}
+
+// IntersectsBox reports whether src and b intersect like the PostgreSQL ?# operator.
+func (src Lseg) IntersectsBox(b Box) bool {
+	low, high := b.corners()
+
+	if src.P[0].X > high.X && src.P[1].X > high.X ||
+		src.P[0].X < low.X && src.P[1].X < low.X ||
+		src.P[0].Y > high.Y && src.P[1].Y > high.Y ||
+		src.P[0].Y < low.Y && src.P[1].Y < low.Y {
+		return false
+	}
+
+	if b.containsPoint(src.P[0]) || b.containsPoint(src.P[1]) {
+		return true
+	}
+
+	for _, side := range b.sides() {
+		if src.Intersects(side) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClosestPoint returns the point on src closest to p like the PostgreSQL ## operator.
+func (src Lseg) ClosestPoint(p Point) Point {
+	closest, _ := src.closestPoint(p.P)
+	return newPoint(closest)
+}
+
+func (src Lseg) closestPoint(p Vec2) (Vec2, float64) {
+	// Draw a perpendicular line from p to the segment.
+	tmp := newLineFromSlope(p, pointInverseSlope(src.P[0], src.P[1]))
+	closest := src.closestPointToLine(tmp)
+	return closest, pointDistance(closest, p)
+}
+
+func (src Lseg) closestPointToLine(l Line) Vec2 {
+	if p, ok := src.IntersectionWithLine(l); ok {
+		return p.P
+	}
+
+	_, d0 := l.closestPoint(src.P[0])
+	_, d1 := l.closestPoint(src.P[1])
+	if d0 < d1 {
+		return src.P[0]
+	}
+	return src.P[1]
+}
+
+// Distance returns the distance between src and l like the PostgreSQL <-> operator.
+func (src Lseg) Distance(l Lseg) float64 {
+	if src.Intersects(l) {
+		return 0
+	}
+
+	d := math.Inf(1)
+	for _, c := range []struct {
+		seg Lseg
+		p   Vec2
+	}{{src, l.P[0]}, {src, l.P[1]}, {l, src.P[0]}, {l, src.P[1]}} {
+		if _, cd := c.seg.closestPoint(c.p); cd < d {
+			d = cd
+		}
+	}
+	return d
+}
+
+// corners returns the lower left and upper right corners of src regardless of the order of src.P.
+func (src Box) corners() (low, high Vec2) {
+	low = Vec2{X: math.Min(src.P[0].X, src.P[1].X), Y: math.Min(src.P[0].Y, src.P[1].Y)}
+	high = Vec2{X: math.Max(src.P[0].X, src.P[1].X), Y: math.Max(src.P[0].Y, src.P[1].Y)}
+	return low, high
+}
+
+// newBox returns a Box with the upper right corner first like PostgreSQL outputs boxes.
+func newBox(low, high Vec2) Box {
+	return Box{P: [2]Vec2{high, low}, Status: Present}
+}
+
+// rectangle returns the corners of src counterclockwise from the lower left corner.
+func (src Box) rectangle() []Vec2 {
+	low, high := src.corners()
+	return []Vec2{low, {X: high.X, Y: low.Y}, high, {X: low.X, Y: high.Y}}
+}
+
+// boxFromRectangle returns the box with corners points in any order. It returns false if points are not the 4 corners
+// of an axis-aligned rectangle.
+func boxFromRectangle(points []Vec2) (Box, bool) {
+	if len(points) != 4 {
+		return Box{}, false
+	}
+
+	box := boundingBox(points)
+	for _, c := range box.rectangle() {
+		found := false
+		for _, p := range points {
+			if p == c {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return Box{}, false
+		}
+	}
+	return box, true
+}
+
+// sides returns the sides of src in the order PostgreSQL checks them.
+func (src Box) sides() [4]Lseg {
+	low, high := src.corners()
+	upperLeft := Vec2{X: low.X, Y: high.Y}
+	lowerRight := Vec2{X: high.X, Y: low.Y}
+	return [4]Lseg{
+		{P: [2]Vec2{low, upperLeft}, Status: Present},
+		{P: [2]Vec2{high, upperLeft}, Status: Present},
+		{P: [2]Vec2{low, lowerRight}, Status: Present},
+		{P: [2]Vec2{high, lowerRight}, Status: Present},
+	}
+}
+
+// Width returns the width of src like the PostgreSQL width function.
+func (src Box) Width() float64 {
+	return math.Abs(src.P[0].X - src.P[1].X)
+}
+
+// Height returns the height of src like the PostgreSQL height function.
+func (src Box) Height() float64 {
+	return math.Abs(src.P[0].Y - src.P[1].Y)
+}
+
+// Area returns the area of src like the PostgreSQL area function.
+func (src Box) Area() float64 {
+	return src.Width() * src.Height()
+}
+
+// Center returns the center of src like the PostgreSQL @@ operator.
+func (src Box) Center() Point {
+	return newPoint(Vec2{X: (src.P[0].X + src.P[1].X) / 2, Y: (src.P[0].Y + src.P[1].Y) / 2})
+}
+
+func (src Box) containsPoint(p Vec2) bool {
+	low, high := src.corners()
+	return high.X >= p.X && low.X <= p.X && high.Y >= p.Y && low.Y <= p.Y
+}
+
+// ContainsPoint reports whether src contains p like the PostgreSQL @> operator.
+func (src Box) ContainsPoint(p Point) bool {
+	return src.containsPoint(p.P)
+}
+
+// ContainsBox reports whether src contains b like the PostgreSQL @> operator.
+func (src Box) ContainsBox(b Box) bool {
+	low, high := src.corners()
+	blow, bhigh := b.corners()
+	return fpGe(high.X, bhigh.X) && fpLe(low.X, blow.X) && fpGe(high.Y, bhigh.Y) && fpLe(low.Y, blow.Y)
+}
+
+// Overlaps reports whether src and b overlap like the PostgreSQL && and ?# operators.
+func (src Box) Overlaps(b Box) bool {
+	low, high := src.corners()
+	blow, bhigh := b.corners()
+	return fpLe(low.X, bhigh.X) && fpLe(blow.X, high.X) && fpLe(low.Y, bhigh.Y) && fpLe(blow.Y, high.Y)
+}
+
+// Intersection returns the box where src and b overlap like the PostgreSQL # operator. It returns false if they do
+// not overlap.
+func (src Box) Intersection(b Box) (Box, bool) {
+	if !src.Overlaps(b) {
+		return Box{}, false
+	}
+
+	low, high := src.corners()
+	blow, bhigh := b.corners()
+	return newBox(
+		Vec2{X: math.Max(low.X, blow.X), Y: math.Max(low.Y, blow.Y)},
+		Vec2{X: math.Min(high.X, bhigh.X), Y: math.Min(high.Y, bhigh.Y)},
+	), true
+}
+
+// ClosestPoint returns the point on or in src closest to p like the PostgreSQL ## operator.
+func (src Box) ClosestPoint(p Point) Point {
+	closest, _ := src.closestPoint(p.P)
+	return newPoint(closest)
+}
+
+func (src Box) closestPoint(p Vec2) (Vec2, float64) {
+	if src.containsPoint(p) {
+		return p, 0
+	}
+
+	var closest Vec2
+	d := math.Inf(1)
+	for _, side := range src.sides() {
+		if c, sd := side.closestPoint(p); sd < d {
+			closest, d = c, sd
+		}
+	}
+	return closest, d
+}
+
+// boundingBox returns the smallest box containing points.
+func boundingBox(points []Vec2) Box {
+	if len(points) == 0 {
+		return Box{Status: Present}
+	}
+
+	low, high := points[0], points[0]
+	for _, p := range points[1:] {
+		low.X, low.Y = math.Min(low.X, p.X), math.Min(low.Y, p.Y)
+		high.X, high.Y = math.Max(high.X, p.X), math.Max(high.Y, p.Y)
+	}
+	return newBox(low, high)
+}
+
+// Area returns the area of src like the PostgreSQL area function.
+func (src Circle) Area() float64 {
+	return math.Pi * src.R * src.R
+}
+
+// Center returns the center of src like the PostgreSQL @@ operator.
+func (src Circle) Center() Point {
+	return newPoint(src.P)
+}
+
+// ContainsPoint reports whether src contains p like the PostgreSQL @> operator.
+func (src Circle) ContainsPoint(p Point) bool {
+	return pointDistance(src.P, p.P) <= src.R
+}
+
+// ContainsCircle reports whether src contains c like the PostgreSQL @> operator.
+func (src Circle) ContainsCircle(c Circle) bool {
+	return fpLe(pointDistance(src.P, c.P)+c.R, src.R)
+}
+
+// Overlaps reports whether src and c overlap like the PostgreSQL && operator.
+func (src Circle) Overlaps(c Circle) bool {
+	return fpLe(pointDistance(src.P, c.P), src.R+c.R)
+}
+
+// Distance returns the distance between src and c like the PostgreSQL <-> operator. It is 0 if they overlap.
+func (src Circle) Distance(c Circle) float64 {
+	return math.Max(pointDistance(src.P, c.P)-(src.R+c.R), 0)
+}
+
+// BoundingBox returns the smallest box containing src. Note that the PostgreSQL box(circle) function returns the box
+// inscribed in the circle instead.
+func (src Circle) BoundingBox() Box {
+	return newBox(Vec2{X: src.P.X - src.R, Y: src.P.Y - src.R}, Vec2{X: src.P.X + src.R, Y: src.P.Y + src.R})
+}
+
+// Polygon returns a polygon with n vertices on src like the PostgreSQL polygon(n, circle) function.
+func (src Circle) Polygon(n int) (Polygon, error) {
+	if n < 2 {
+		return Polygon{}, fmt.Errorf("cannot approximate circle with %d points", n)
+	}
+	if src.R == 0 {
+		return Polygon{}, errors.New("cannot approximate circle with radius zero")
+	}
+
+	step := 2 * math.Pi / float64(n)
+	points := make([]Vec2, n)
+	for i := range points {
+		angle := float64(i) * step
+		points[i] = Vec2{X: src.P.X - src.R*math.Cos(angle), Y: src.P.Y + src.R*math.Sin(angle)}
+	}
+	return Polygon{P: points, Status: Present}, nil
+}
+
+// pathArea returns the area enclosed by points with the shoelace formula.
+func pathArea(points []Vec2) float64 {
+	var area float64
+	for i := range points {
+		j := (i + 1) % len(points)
+		area += points[i].X*points[j].Y - points[i].Y*points[j].X
+	}
+	return math.Abs(area * 0.5)
+}
+
+// Length returns the length of src like the PostgreSQL length function. It includes the segment from the last point
+// to the first if src is closed.
+func (src Path) Length() float64 {
+	var length float64
+	for _, seg := range pathSegments(src.P, src.Closed) {
+		length += seg.Length()
+	}
+	return length
+}
+
+// Area returns the area of a closed path like the PostgreSQL area function. It returns false if src is open.
+func (src Path) Area() (float64, bool) {
+	if !src.Closed {
+		return 0, false
+	}
+	return pathArea(src.P), true
+}
+
+// BoundingBox returns the smallest box containing src.
+func (src Path) BoundingBox() Box {
+	return boundingBox(src.P)
+}
+
+// Intersects reports whether any segments of src and p intersect like the PostgreSQL ?# operator.
+func (src Path) Intersects(p Path) bool {
+	if len(src.P) == 0 || len(p.P) == 0 {
+		return false
+	}
+	if !src.BoundingBox().Overlaps(p.BoundingBox()) {
+		return false
+	}
+
+	segs1 := pathSegments(src.P, src.Closed)
+	segs2 := pathSegments(p.P, p.Closed)
+	// A path with a single point is treated as a segment of zero length.
+	if len(segs1) == 0 {
+		segs1 = []Lseg{{P: [2]Vec2{src.P[0], src.P[0]}, Status: Present}}
+	}
+	if len(segs2) == 0 {
+		segs2 = []Lseg{{P: [2]Vec2{p.P[0], p.P[0]}, Status: Present}}
+	}
+
+	for _, s1 := range segs1 {
+		for _, s2 := range segs2 {
+			if s1.Intersects(s2) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Area returns the area of src.
+func (src Polygon) Area() float64 {
+	return pathArea(src.P)
+}
+
+// Center returns the average of the vertices of src like the PostgreSQL @@ operator.
+func (src Polygon) Center() Point {
+	var c Vec2
+	for _, p := range src.P {
+		c.X += p.X
+		c.Y += p.Y
+	}
+	if n := float64(len(src.P)); n > 0 {
+		c.X /= n
+		c.Y /= n
+	}
+	return newPoint(c)
+}
+
+// BoundingBox returns the smallest box containing src like the PostgreSQL box(polygon) function.
+func (src Polygon) BoundingBox() Box {
+	return boundingBox(src.P)
+}
+
+// ContainsPoint reports whether src contains p like the PostgreSQL @> operator. Points on the boundary are contained.
+func (src Polygon) ContainsPoint(p Point) bool {
+	return pointInPolygon(p.P, src.P) != 0
+}
+
+// pointOnPolygon is returned by pointInPolygon and lsegCrossing for a point on the boundary.
+const pointOnPolygon = math.MaxInt32
+
+// pointInPolygon returns 0 if p is outside the polygon, 1 if it is inside and 2 if it is on the boundary. It counts
+// how many times the polygon winds around p.
+func pointInPolygon(p Vec2, points []Vec2) int {
+	if len(points) == 0 {
+		return 0
+	}
+
+	x0, y0 := points[0].X-p.X, points[0].Y-p.Y
+	prevX, prevY := x0, y0
+	totalCross := 0
+
+	for i := 1; i < len(points); i++ {
+		x, y := points[i].X-p.X, points[i].Y-p.Y
+		cross := lsegCrossing(x, y, prevX, prevY)
+		if cross == pointOnPolygon {
+			return 2
+		}
+		totalCross += cross
+		prevX, prevY = x, y
+	}
+
+	cross := lsegCrossing(x0, y0, prevX, prevY)
+	if cross == pointOnPolygon {
+		return 2
+	}
+	totalCross += cross
+
+	if totalCross != 0 {
+		return 1
+	}
+	return 0
+}
+
+// lsegCrossing returns +/-2 if the segment from (prevX, prevY) to (x, y) crosses the positive X axis, +/-1 if one
+// end point is on the positive X axis, 0 if it does not cross it, and pointOnPolygon if it passes through the origin.
+func lsegCrossing(x, y, prevX, prevY float64) int {
+	if fpZero(y) {
+		switch {
+		case fpZero(x):
+			return pointOnPolygon
+		case fpGt(x, 0):
+			if fpZero(prevY) {
+				if fpGt(prevX, 0) {
+					return 0
+				}
+				return pointOnPolygon
+			}
+			if fpLt(prevY, 0) {
+				return 1
+			}
+			return -1
+		default:
+			if fpZero(prevY) {
+				if fpLt(prevX, 0) {
+					return 0
+				}
+				return pointOnPolygon
+			}
+			return 0
+		}
+	}
+
+	ySign := -1
+	if fpGt(y, 0) {
+		ySign = 1
+	}
+
+	switch {
+	case fpZero(prevY):
+		if fpLt(prevX, 0) {
+			return 0
+		}
+		return ySign
+	case ySign < 0 && fpLt(prevY, 0) || ySign > 0 && fpGt(prevY, 0):
+		return 0
+	case fpGe(x, 0) && fpGt(prevX, 0):
+		return 2 * ySign
+	case fpLt(x, 0) && fpLe(prevX, 0):
+		return 0
+	}
+
+	z := (x-prevX)*y - (y-prevY)*x
+	if fpZero(z) {
+		return pointOnPolygon
+	}
+	if ySign < 0 && fpLt(z, 0) || ySign > 0 && fpGt(z, 0) {
+		return 0
+	}
+	return 2 * ySign
+}
@@ -0,0 +1,255 @@
+package pgtype_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func vec(x, y float64) pgtype.Vec2 {
+	return pgtype.Vec2{X: x, Y: y}
+}
+
+func pt(x, y float64) pgtype.Point {
+	return pgtype.Point{P: vec(x, y), Status: pgtype.Present}
+}
+
+func TestPointDistances(t *testing.T) {
+	p := pt(3, 4)
+	assert.Equal(t, 5.0, p.Distance(pt(0, 0)))
+	assert.InDelta(t, 2.0, p.DistanceToLine(pgtype.Line{A: 1, B: 0, C: -1, Status: pgtype.Present}), 1e-9)
+	assert.InDelta(t, 4.0, p.DistanceToLseg(pgtype.Lseg{P: [2]pgtype.Vec2{vec(-5, 0), vec(5, 0)}, Status: pgtype.Present}), 1e-9)
+	assert.InDelta(t, 5.0, p.DistanceToLseg(pgtype.Lseg{P: [2]pgtype.Vec2{vec(-5, 0), vec(0, 0)}, Status: pgtype.Present}), 1e-9)
+	assert.InDelta(t, math.Sqrt2, p.DistanceToBox(pgtype.Box{P: [2]pgtype.Vec2{vec(2, 3), vec(0, 0)}, Status: pgtype.Present}), 1e-9)
+	assert.Equal(t, 0.0, p.DistanceToBox(pgtype.Box{P: [2]pgtype.Vec2{vec(0, 0), vec(10, 10)}, Status: pgtype.Present}))
+	assert.InDelta(t, 3.0, p.DistanceToCircle(pgtype.Circle{P: vec(0, 0), R: 2, Status: pgtype.Present}), 1e-9)
+	assert.Equal(t, 0.0, p.DistanceToCircle(pgtype.Circle{P: vec(0, 0), R: 10, Status: pgtype.Present}))
+
+	square := []pgtype.Vec2{vec(0, 0), vec(0, 2), vec(2, 2), vec(2, 0)}
+	assert.Equal(t, 0.0, pt(1, 1).DistanceToPolygon(pgtype.Polygon{P: square, Status: pgtype.Present}))
+	assert.InDelta(t, 1.0, pt(1, 1).DistanceToPath(pgtype.Path{P: square, Closed: true, Status: pgtype.Present}), 1e-9)
+	assert.InDelta(t, 1.0, pt(1, -1).DistanceToPath(pgtype.Path{P: square, Closed: true, Status: pgtype.Present}), 1e-9)
+	assert.InDelta(t, math.Sqrt2, pt(1, -1).DistanceToPath(pgtype.Path{P: square, Closed: false, Status: pgtype.Present}), 1e-9)
+	assert.InDelta(t, 1.0, pt(3, 2).DistanceToPolygon(pgtype.Polygon{P: square, Status: pgtype.Present}), 1e-9)
+}
+
+func TestLineConversion(t *testing.T) {
+	l, err := pgtype.NewLineFromPoints(vec(0, 1), vec(2, 5))
+	require.NoError(t, err)
+	assert.Equal(t, pgtype.Line{A: 2, B: -1, C: 1, Status: pgtype.Present}, l)
+
+	p1, p2, err := l.Points()
+	require.NoError(t, err)
+	assert.Equal(t, vec(0, 1), p1)
+	assert.Equal(t, vec(1, 3), p2)
+
+	vertical, err := pgtype.NewLineFromPoints(vec(3, 0), vec(3, 5))
+	require.NoError(t, err)
+	assert.Equal(t, pgtype.Line{A: -1, B: 0, C: 3, Status: pgtype.Present}, vertical)
+	p1, p2, err = vertical.Points()
+	require.NoError(t, err)
+	assert.Equal(t, vec(3, 0), p1)
+	assert.Equal(t, vec(3, 1), p2)
+
+	horizontal, err := pgtype.NewLineFromPoints(vec(0, 2), vec(5, 2))
+	require.NoError(t, err)
+	assert.Equal(t, pgtype.Line{A: 0, B: -1, C: 2, Status: pgtype.Present}, horizontal)
+
+	_, err = pgtype.NewLineFromPoints(vec(1, 1), vec(1, 1))
+	require.Error(t, err)
+	_, _, err = pgtype.Line{Status: pgtype.Present}.Points()
+	require.Error(t, err)
+
+	lseg := pgtype.Lseg{P: [2]pgtype.Vec2{vec(0, 1), vec(2, 5)}, Status: pgtype.Present}
+	segLine, err := lseg.Line()
+	require.NoError(t, err)
+	assert.Equal(t, l, segLine)
+}
+
+func TestLineIntersection(t *testing.T) {
+	vertical := pgtype.Line{A: -1, B: 0, C: 3, Status: pgtype.Present}
+	horizontal := pgtype.Line{A: 0, B: -1, C: 2, Status: pgtype.Present}
+
+	p, ok := vertical.Intersection(horizontal)
+	require.True(t, ok)
+	assert.Equal(t, pt(3, 2), p)
+	assert.True(t, horizontal.Intersects(vertical))
+	assert.False(t, horizontal.Intersects(pgtype.Line{A: 0, B: -2, C: 7, Status: pgtype.Present}))
+
+	assert.Equal(t, pt(3, 7), vertical.ClosestPoint(pt(10, 7)))
+	assert.Equal(t, pt(-4, 2), horizontal.ClosestPoint(pt(-4, -8)))
+}
+
+func TestLsegOperations(t *testing.T) {
+	a := pgtype.Lseg{P: [2]pgtype.Vec2{vec(0, 0), vec(4, 4)}, Status: pgtype.Present}
+	b := pgtype.Lseg{P: [2]pgtype.Vec2{vec(0, 4), vec(4, 0)}, Status: pgtype.Present}
+	c := pgtype.Lseg{P: [2]pgtype.Vec2{vec(5, 0), vec(6, 0)}, Status: pgtype.Present}
+
+	assert.InDelta(t, 4*math.Sqrt2, a.Length(), 1e-9)
+	assert.Equal(t, pt(2, 2), a.Center())
+
+	p, ok := a.Intersection(b)
+	require.True(t, ok)
+	assert.Equal(t, pt(2, 2), p)
+	assert.True(t, a.Intersects(b))
+	assert.False(t, a.Intersects(c))
+	assert.Equal(t, 0.0, a.Distance(b))
+	assert.InDelta(t, 1.0, b.Distance(c), 1e-9)
+
+	// Collinear segments have no intersection point in PostgreSQL.
+	assert.False(t, a.Intersects(pgtype.Lseg{P: [2]pgtype.Vec2{vec(1, 1), vec(5, 5)}, Status: pgtype.Present}))
+
+	assert.True(t, a.IntersectsLine(pgtype.Line{A: 0, B: -1, C: 1, Status: pgtype.Present}))
+	assert.False(t, a.IntersectsLine(pgtype.Line{A: 0, B: -1, C: 5, Status: pgtype.Present}))
+
+	assert.Equal(t, pt(2, 2), a.ClosestPoint(pt(0, 4)))
+	assert.Equal(t, pt(4, 4), a.ClosestPoint(pt(10, 10)))
+
+	box := pgtype.Box{P: [2]pgtype.Vec2{vec(3, 3), vec(1, 1)}, Status: pgtype.Present}
+	assert.True(t, a.IntersectsBox(box))
+	assert.True(t, b.IntersectsBox(box))
+	assert.False(t, c.IntersectsBox(box))
+	assert.True(t, pgtype.Lseg{P: [2]pgtype.Vec2{vec(0, 2), vec(5, 2)}, Status: pgtype.Present}.IntersectsBox(box))
+}
+
+func TestBoxOperations(t *testing.T) {
+	box := pgtype.Box{P: [2]pgtype.Vec2{vec(4, 3), vec(0, 0)}, Status: pgtype.Present}
+
+	assert.Equal(t, 4.0, box.Width())
+	assert.Equal(t, 3.0, box.Height())
+	assert.Equal(t, 12.0, box.Area())
+	assert.Equal(t, pt(2, 1.5), box.Center())
+
+	assert.True(t, box.ContainsPoint(pt(4, 3)))
+	assert.True(t, box.ContainsPoint(pt(1, 1)))
+	assert.False(t, box.ContainsPoint(pt(5, 1)))
+	assert.True(t, box.ContainsBox(pgtype.Box{P: [2]pgtype.Vec2{vec(1, 1), vec(2, 2)}, Status: pgtype.Present}))
+	assert.False(t, box.ContainsBox(pgtype.Box{P: [2]pgtype.Vec2{vec(1, 1), vec(5, 2)}, Status: pgtype.Present}))
+
+	other := pgtype.Box{P: [2]pgtype.Vec2{vec(6, 6), vec(2, 2)}, Status: pgtype.Present}
+	assert.True(t, box.Overlaps(other))
+	intersection, ok := box.Intersection(other)
+	require.True(t, ok)
+	assert.Equal(t, pgtype.Box{P: [2]pgtype.Vec2{vec(4, 3), vec(2, 2)}, Status: pgtype.Present}, intersection)
+
+	_, ok = box.Intersection(pgtype.Box{P: [2]pgtype.Vec2{vec(10, 10), vec(9, 9)}, Status: pgtype.Present})
+	assert.False(t, ok)
+
+	assert.Equal(t, pt(4, 2), box.ClosestPoint(pt(6, 2)))
+	assert.Equal(t, pt(4, 3), box.ClosestPoint(pt(6, 6)))
+	assert.Equal(t, pt(1, 1), box.ClosestPoint(pt(1, 1)))
+}
+
+func TestCircleOperations(t *testing.T) {
+	c := pgtype.Circle{P: vec(1, 1), R: 2, Status: pgtype.Present}
+
+	assert.InDelta(t, 4*math.Pi, c.Area(), 1e-9)
+	assert.Equal(t, pt(1, 1), c.Center())
+	assert.True(t, c.ContainsPoint(pt(3, 1)))
+	assert.False(t, c.ContainsPoint(pt(3, 3)))
+	assert.True(t, c.ContainsCircle(pgtype.Circle{P: vec(1.5, 1), R: 1, Status: pgtype.Present}))
+	assert.False(t, c.ContainsCircle(pgtype.Circle{P: vec(2.5, 1), R: 1, Status: pgtype.Present}))
+	assert.True(t, c.Overlaps(pgtype.Circle{P: vec(5, 1), R: 2, Status: pgtype.Present}))
+	assert.False(t, c.Overlaps(pgtype.Circle{P: vec(6, 1), R: 2, Status: pgtype.Present}))
+	assert.InDelta(t, 1.0, c.Distance(pgtype.Circle{P: vec(6, 1), R: 2, Status: pgtype.Present}), 1e-9)
+	assert.Equal(t, pgtype.Box{P: [2]pgtype.Vec2{vec(3, 3), vec(-1, -1)}, Status: pgtype.Present}, c.BoundingBox())
+}
+
+func TestPathAndPolygonOperations(t *testing.T) {
+	triangle := []pgtype.Vec2{vec(0, 0), vec(4, 0), vec(4, 3)}
+
+	open := pgtype.Path{P: triangle, Status: pgtype.Present}
+	closed := pgtype.Path{P: triangle, Closed: true, Status: pgtype.Present}
+	assert.Equal(t, 7.0, open.Length())
+	assert.Equal(t, 12.0, closed.Length())
+	_, ok := open.Area()
+	assert.False(t, ok)
+	area, ok := closed.Area()
+	require.True(t, ok)
+	assert.Equal(t, 6.0, area)
+	assert.Equal(t, pgtype.Box{P: [2]pgtype.Vec2{vec(4, 3), vec(0, 0)}, Status: pgtype.Present}, open.BoundingBox())
+
+	crossing := pgtype.Path{P: []pgtype.Vec2{vec(2, -1), vec(2, 5)}, Status: pgtype.Present}
+	assert.True(t, open.Intersects(crossing))
+	assert.False(t, open.Intersects(pgtype.Path{P: []pgtype.Vec2{vec(5, 5), vec(6, 6)}, Status: pgtype.Present}))
+
+	polygon := pgtype.Polygon{P: triangle, Status: pgtype.Present}
+	assert.Equal(t, 6.0, polygon.Area())
+	assert.InDelta(t, 8.0/3, polygon.Center().P.X, 1e-9)
+	assert.InDelta(t, 1.0, polygon.Center().P.Y, 1e-9)
+	assert.Equal(t, open.BoundingBox(), polygon.BoundingBox())
+
+	assert.True(t, polygon.ContainsPoint(pt(3, 1)))
+	assert.True(t, polygon.ContainsPoint(pt(2, 0)))
+	assert.True(t, polygon.ContainsPoint(pt(4, 3)))
+	assert.False(t, polygon.ContainsPoint(pt(1, 2)))
+	assert.False(t, polygon.ContainsPoint(pt(5, 0)))
+
+	concave := pgtype.Polygon{P: []pgtype.Vec2{vec(0, 0), vec(4, 0), vec(4, 4), vec(2, 1), vec(0, 4)}, Status: pgtype.Present}
+	assert.True(t, concave.ContainsPoint(pt(1, 1)))
+	assert.False(t, concave.ContainsPoint(pt(2, 3)))
+	assert.True(t, concave.ContainsPoint(pt(2, 1)))
+}
+
+func TestGeometricOperationsMatchServer(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	points := []string{"(0,0)", "(1,1)", "(3,1)", "(5,5)", "(2,0)", "(-1,2)", "(2,3)"}
+	polygon := "((0,0),(4,0),(4,4),(2,1),(0,4))"
+	box := "(3,3),(1,1)"
+	lseg := "[(0,4),(4,0)]"
+	line := "{1,-2,3}"
+	path := "[(0,0),(4,0),(4,3)]"
+	circle := "<(1,1),2>"
+
+	for _, ps := range points {
+		var p pgtype.Point
+		require.NoError(t, p.DecodeText(nil, []byte(ps)))
+
+		var inPolygon, inBox, inCircle bool
+		var dPolygon, dBox, dLseg, dLine, dPath, dCircle float64
+		var closestLseg, closestBox, closestLine pgtype.Point
+		err := conn.QueryRow(context.Background(), `select
+	$2::polygon @> $1::point, $3::box @> $1::point, $7::circle @> $1::point,
+	$1::point <-> $2::polygon, $1::point <-> $3::box, $1::point <-> $4::lseg, $1::point <-> $5::line, $1::point <-> $6::path, $1::point <-> $7::circle,
+	$1::point ## $4::lseg, $1::point ## $3::box, $1::point ## $5::line`,
+			ps, polygon, box, lseg, line, path, circle,
+		).Scan(&inPolygon, &inBox, &inCircle, &dPolygon, &dBox, &dLseg, &dLine, &dPath, &dCircle, &closestLseg, &closestBox, &closestLine)
+		require.NoError(t, err)
+
+		var poly pgtype.Polygon
+		require.NoError(t, poly.DecodeText(nil, []byte(polygon)))
+		var b pgtype.Box
+		require.NoError(t, b.DecodeText(nil, []byte(box)))
+		var ls pgtype.Lseg
+		require.NoError(t, ls.DecodeText(nil, []byte(lseg)))
+		var l pgtype.Line
+		require.NoError(t, l.DecodeText(nil, []byte(line)))
+		var pa pgtype.Path
+		require.NoError(t, pa.DecodeText(nil, []byte(path)))
+		var c pgtype.Circle
+		require.NoError(t, c.DecodeText(nil, []byte(circle)))
+
+		assert.Equalf(t, inPolygon, poly.ContainsPoint(p), "%s", ps)
+		assert.Equalf(t, inBox, b.ContainsPoint(p), "%s", ps)
+		assert.Equalf(t, inCircle, c.ContainsPoint(p), "%s", ps)
+		assert.InDeltaf(t, dPolygon, p.DistanceToPolygon(poly), 1e-9, "%s", ps)
+		assert.InDeltaf(t, dBox, p.DistanceToBox(b), 1e-9, "%s", ps)
+		assert.InDeltaf(t, dLseg, p.DistanceToLseg(ls), 1e-9, "%s", ps)
+		assert.InDeltaf(t, dLine, p.DistanceToLine(l), 1e-9, "%s", ps)
+		assert.InDeltaf(t, dPath, p.DistanceToPath(pa), 1e-9, "%s", ps)
+		assert.InDeltaf(t, dCircle, p.DistanceToCircle(c), 1e-9, "%s", ps)
+		assert.InDeltaf(t, closestLseg.P.X, ls.ClosestPoint(p).P.X, 1e-9, "%s", ps)
+		assert.InDeltaf(t, closestLseg.P.Y, ls.ClosestPoint(p).P.Y, 1e-9, "%s", ps)
+		assert.InDeltaf(t, closestBox.P.X, b.ClosestPoint(p).P.X, 1e-9, "%s", ps)
+		assert.InDeltaf(t, closestBox.P.Y, b.ClosestPoint(p).P.Y, 1e-9, "%s", ps)
+		assert.InDeltaf(t, closestLine.P.X, l.ClosestPoint(p).P.X, 1e-9, "%s", ps)
+		assert.InDeltaf(t, closestLine.P.Y, l.ClosestPoint(p).P.Y, 1e-9, "%s", ps)
+	}
+}
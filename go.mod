@@ -1,13 +1,28 @@
 module github.com/jackc/pgtype
 
-go 1.13
+go 1.18
 
 require (
+	github.com/cockroachdb/apd/v3 v3.2.3
+	github.com/ericlagergren/decimal v0.0.0-20190204014639-71cf34b7c2b5
 	github.com/gofrs/uuid v4.0.0+incompatible
+	github.com/google/uuid v1.3.0
 	github.com/jackc/pgconn v1.14.3
 	github.com/jackc/pgio v1.0.0
 	github.com/jackc/pgx/v4 v4.18.2
-	github.com/lib/pq v1.10.2
+	github.com/lib/pq v1.10.7 // minimum version required by github.com/cockroachdb/apd/v3
 	github.com/shopspring/decimal v1.2.0
 	github.com/stretchr/testify v1.8.1
 )
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/jackc/chunkreader/v2 v2.0.1 // indirect
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgproto3/v2 v2.3.3 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20221227161230-091c0ba34f0a // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/crypto v0.20.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
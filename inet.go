@@ -5,6 +5,7 @@ import (
 	"encoding"
 	"fmt"
 	"net"
+	"net/netip"
 	"strings"
 )
 
@@ -70,6 +71,21 @@ func (dst *Inet) Set(src interface{}) error {
 		}
 
 		*dst = Inet{IPNet: ipnet, Status: Present}
+	case netip.Addr:
+		if !value.IsValid() {
+			*dst = Inet{Status: Null}
+		} else {
+			bitCount := value.BitLen()
+			*dst = Inet{IPNet: &net.IPNet{IP: value.AsSlice(), Mask: net.CIDRMask(bitCount, bitCount)}, Status: Present}
+		}
+	case netip.Prefix:
+		if !value.IsValid() {
+			*dst = Inet{Status: Null}
+		} else {
+			addr := value.Addr()
+			mask := net.CIDRMask(value.Bits(), addr.BitLen())
+			*dst = Inet{IPNet: &net.IPNet{IP: addr.AsSlice(), Mask: mask}, Status: Present}
+		}
 	case *net.IPNet:
 		if value == nil {
 			*dst = Inet{Status: Null}
@@ -88,6 +104,18 @@ func (dst *Inet) Set(src interface{}) error {
 		} else {
 			return dst.Set(*value)
 		}
+	case *netip.Addr:
+		if value == nil {
+			*dst = Inet{Status: Null}
+		} else {
+			return dst.Set(*value)
+		}
+	case *netip.Prefix:
+		if value == nil {
+			*dst = Inet{Status: Null}
+		} else {
+			return dst.Set(*value)
+		}
 	default:
 		if tv, ok := src.(encoding.TextMarshaler); ok {
 			text, err := tv.MarshalText()
@@ -157,6 +185,24 @@ func (src *Inet) AssignTo(dst interface{}) error {
 			*v = make(net.IP, len(src.IPNet.IP))
 			copy(*v, src.IPNet.IP)
 			return nil
+		case *netip.Addr:
+			if oneCount, bitCount := src.IPNet.Mask.Size(); oneCount != bitCount {
+				return fmt.Errorf("cannot assign %v to %T", src, dst)
+			}
+			addr, ok := src.netipAddr()
+			if !ok {
+				return fmt.Errorf("cannot assign %v to %T", src, dst)
+			}
+			*v = addr
+			return nil
+		case *netip.Prefix:
+			addr, ok := src.netipAddr()
+			if !ok {
+				return fmt.Errorf("cannot assign %v to %T", src, dst)
+			}
+			oneCount, _ := src.IPNet.Mask.Size()
+			*v = netip.PrefixFrom(addr, oneCount)
+			return nil
 		default:
 			if tv, ok := dst.(encoding.TextUnmarshaler); ok {
 				if err := tv.UnmarshalText([]byte(src.IPNet.String())); err != nil {
@@ -176,6 +222,19 @@ func (src *Inet) AssignTo(dst interface{}) error {
 	return fmt.Errorf("cannot decode %#v into %T", src, dst)
 }
 
+// netipAddr converts the IP of src to a netip.Addr. An IPv4 address stored in 16 bytes is unmapped when the mask is
+// IPv4 sized.
+func (src *Inet) netipAddr() (netip.Addr, bool) {
+	addr, ok := netip.AddrFromSlice(src.IPNet.IP)
+	if !ok {
+		return netip.Addr{}, false
+	}
+	if len(src.IPNet.Mask) == net.IPv4len {
+		addr = addr.Unmap()
+	}
+	return addr, true
+}
+
 func (dst *Inet) DecodeText(ci *ConnInfo, src []byte) error {
 	if src == nil {
 		*dst = Inet{Status: Null}
@@ -3,6 +3,7 @@ package pgtype_test
 import (
 	"fmt"
 	"net"
+	"net/netip"
 	"reflect"
 	"strings"
 	"testing"
@@ -74,6 +75,15 @@ func TestInetSet(t *testing.T) {
 			b.WriteString(s)
 			return &b
 		}("127.0.0.1"), result: pgtype.Inet{IPNet: mustParseInet(t, "127.0.0.1"), Status: pgtype.Present}},
+		{source: netip.MustParseAddr("127.0.0.1"), result: pgtype.Inet{IPNet: mustParseInet(t, "127.0.0.1"), Status: pgtype.Present}},
+		{source: netip.MustParseAddr("2607:f8b0:4009:80b::200e"), result: pgtype.Inet{IPNet: mustParseInet(t, "2607:f8b0:4009:80b::200e"), Status: pgtype.Present}},
+		{source: netip.Addr{}, result: pgtype.Inet{Status: pgtype.Null}},
+		{source: netip.MustParsePrefix("1.2.3.4/24"), result: pgtype.Inet{IPNet: &net.IPNet{IP: net.ParseIP("1.2.3.4").To4(), Mask: net.CIDRMask(24, 32)}, Status: pgtype.Present}},
+		{source: netip.MustParsePrefix("::ffff:0.0.0.0/104"), result: pgtype.Inet{IPNet: &net.IPNet{IP: net.ParseIP("::ffff:0.0.0.0"), Mask: net.CIDRMask(104, 128)}, Status: pgtype.Present}},
+		{source: netip.Prefix{}, result: pgtype.Inet{Status: pgtype.Null}},
+		{source: func(a netip.Addr) *netip.Addr { return &a }(netip.MustParseAddr("10.0.0.1")), result: pgtype.Inet{IPNet: mustParseInet(t, "10.0.0.1"), Status: pgtype.Present}},
+		{source: (*netip.Addr)(nil), result: pgtype.Inet{Status: pgtype.Null}},
+		{source: (*netip.Prefix)(nil), result: pgtype.Inet{Status: pgtype.Null}},
 	}
 
 	for i, tt := range successfulTests {
@@ -109,6 +119,9 @@ func TestInetAssignTo(t *testing.T) {
 	var pip *net.IP
 	var um textUnmarshaler
 	var pum *textUnmarshaler
+	var addr netip.Addr
+	var paddr *netip.Addr
+	var prefix netip.Prefix
 
 	simpleTests := []struct {
 		src      pgtype.Inet
@@ -121,6 +134,12 @@ func TestInetAssignTo(t *testing.T) {
 		{src: pgtype.Inet{Status: pgtype.Null}, dst: &pipnet, expected: ((*net.IPNet)(nil))},
 		{src: pgtype.Inet{Status: pgtype.Null}, dst: &pip, expected: ((*net.IP)(nil))},
 		{src: pgtype.Inet{Status: pgtype.Null}, dst: &pum, expected: ((*textUnmarshaler)(nil))},
+		{src: pgtype.Inet{IPNet: mustParseCIDR(t, "127.0.0.1/32"), Status: pgtype.Present}, dst: &addr, expected: netip.MustParseAddr("127.0.0.1")},
+		{src: pgtype.Inet{IPNet: mustParseInet(t, "::1"), Status: pgtype.Present}, dst: &addr, expected: netip.MustParseAddr("::1")},
+		{src: pgtype.Inet{IPNet: &net.IPNet{IP: net.ParseIP("10.0.0.1"), Mask: net.CIDRMask(32, 32)}, Status: pgtype.Present}, dst: &addr, expected: netip.MustParseAddr("10.0.0.1")},
+		{src: pgtype.Inet{IPNet: &net.IPNet{IP: net.ParseIP("1.2.3.4").To4(), Mask: net.CIDRMask(24, 32)}, Status: pgtype.Present}, dst: &prefix, expected: netip.MustParsePrefix("1.2.3.4/24")},
+		{src: pgtype.Inet{IPNet: &net.IPNet{IP: net.ParseIP("::ffff:0.0.0.0"), Mask: net.CIDRMask(104, 128)}, Status: pgtype.Present}, dst: &prefix, expected: netip.MustParsePrefix("::ffff:0.0.0.0/104")},
+		{src: pgtype.Inet{Status: pgtype.Null}, dst: &paddr, expected: ((*netip.Addr)(nil))},
 	}
 
 	for i, tt := range simpleTests {
@@ -141,6 +160,7 @@ func TestInetAssignTo(t *testing.T) {
 	}{
 		{src: pgtype.Inet{IPNet: mustParseCIDR(t, "127.0.0.1/32"), Status: pgtype.Present}, dst: &pipnet, expected: *mustParseCIDR(t, "127.0.0.1/32")},
 		{src: pgtype.Inet{IPNet: mustParseCIDR(t, "127.0.0.1/32"), Status: pgtype.Present}, dst: &pip, expected: mustParseCIDR(t, "127.0.0.1/32").IP},
+		{src: pgtype.Inet{IPNet: mustParseCIDR(t, "127.0.0.1/32"), Status: pgtype.Present}, dst: &paddr, expected: netip.MustParseAddr("127.0.0.1")},
 	}
 
 	for i, tt := range pointerAllocTests {
@@ -159,6 +179,8 @@ func TestInetAssignTo(t *testing.T) {
 		dst interface{}
 	}{
 		{src: pgtype.Inet{IPNet: mustParseCIDR(t, "192.168.0.0/16"), Status: pgtype.Present}, dst: &ip},
+		{src: pgtype.Inet{IPNet: mustParseCIDR(t, "192.168.0.0/16"), Status: pgtype.Present}, dst: &addr},
+		{src: pgtype.Inet{Status: pgtype.Null}, dst: &prefix},
 		{src: pgtype.Inet{Status: pgtype.Null}, dst: &ipnet},
 	}
 
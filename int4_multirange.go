@@ -237,3 +237,193 @@ func (dst *Int4multirange) Scan(src interface{}) error {
 func (src Int4multirange) Value() (driver.Value, error) {
 	return EncodeValueText(src)
 }
+
+// rangeValues returns the normalized range values of src. It is an error if a range is not Present or is not a valid
+// range.
+func (src Int4multirange) rangeValues() ([]rangeValue[Int4], error) {
+	ranges := make([]rangeValue[Int4], len(src.Ranges))
+	for i, r := range src.Ranges {
+		if r.Status != Present {
+			return nil, fmt.Errorf("range %d is not present", i)
+		}
+
+		var err error
+		ranges[i], err = r.rangeValue()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return normalizeRangeValues(ranges, int4RangeElementOps), nil
+}
+
+// rangeValuePair returns the normalized range values of src and x.
+func (src Int4multirange) rangeValuePair(x Int4multirange) ([]rangeValue[Int4], []rangeValue[Int4], error) {
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return nil, nil, err
+	}
+	xRanges, err := x.rangeValues()
+	if err != nil {
+		return nil, nil, err
+	}
+	return ranges, xRanges, nil
+}
+
+func newInt4multirangeFromRangeValues(ranges []rangeValue[Int4]) Int4multirange {
+	dst := Int4multirange{Status: Present}
+	if len(ranges) > 0 {
+		dst.Ranges = make([]Int4range, len(ranges))
+		for i := range ranges {
+			dst.Ranges[i] = newInt4rangeFromRangeValue(ranges[i])
+		}
+	}
+	return dst
+}
+
+// Normalize returns src in the form PostgreSQL uses. Empty ranges are removed, overlapping and adjacent ranges are
+// merged, and the ranges are canonicalized and sorted. It is an error if a range is not Present or is not a valid
+// range. The other operations on Int4multirange have the same requirements.
+func (src Int4multirange) Normalize() (Int4multirange, error) {
+	if src.Status != Present {
+		return Int4multirange{Status: src.Status}, nil
+	}
+
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return Int4multirange{}, err
+	}
+	return newInt4multirangeFromRangeValues(ranges), nil
+}
+
+// IsEmpty reports whether src contains no values.
+func (src Int4multirange) IsEmpty() (bool, error) {
+	if src.Status != Present {
+		return false, nil
+	}
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return false, err
+	}
+	return len(ranges) == 0, nil
+}
+
+// Contains reports whether src contains elem like the PostgreSQL @> operator.
+func (src Int4multirange) Contains(elem Int4) (bool, error) {
+	if src.Status != Present || elem.Status != Present {
+		return false, nil
+	}
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return false, err
+	}
+	for _, r := range ranges {
+		if r.containsElem(elem, int4RangeElementOps) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ContainsRange reports whether src contains r like the PostgreSQL @> operator.
+func (src Int4multirange) ContainsRange(r Int4range) (bool, error) {
+	if src.Status != Present || r.Status != Present {
+		return false, nil
+	}
+	return src.ContainsMultirange(Int4multirange{Ranges: []Int4range{r}, Status: Present})
+}
+
+// ContainsMultirange reports whether src contains x like the PostgreSQL @> operator.
+func (src Int4multirange) ContainsMultirange(x Int4multirange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+
+	for _, xr := range xRanges {
+		contained := false
+		for _, r := range ranges {
+			if r.containsRange(xr, int4RangeElementOps) {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Overlaps reports whether src and x have values in common like the PostgreSQL && operator.
+func (src Int4multirange) Overlaps(x Int4multirange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return len(intersectRangeValues(ranges, xRanges, int4RangeElementOps)) > 0, nil
+}
+
+// Union returns the normalized union of src and x like the PostgreSQL + operator. If either multirange is not Present
+// the result is Null.
+func (src Int4multirange) Union(x Int4multirange) (Int4multirange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Int4multirange{Status: Null}, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return Int4multirange{}, err
+	}
+	return newInt4multirangeFromRangeValues(unionRangeValues(ranges, xRanges, int4RangeElementOps)), nil
+}
+
+// Intersect returns the normalized intersection of src and x like the PostgreSQL * operator. If either multirange is
+// not Present the result is Null.
+func (src Int4multirange) Intersect(x Int4multirange) (Int4multirange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Int4multirange{Status: Null}, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return Int4multirange{}, err
+	}
+	return newInt4multirangeFromRangeValues(intersectRangeValues(ranges, xRanges, int4RangeElementOps)), nil
+}
+
+// Difference returns the normalized values of src that are not in x like the PostgreSQL - operator. If either
+// multirange is not Present the result is Null.
+func (src Int4multirange) Difference(x Int4multirange) (Int4multirange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Int4multirange{Status: Null}, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return Int4multirange{}, err
+	}
+	return newInt4multirangeFromRangeValues(differenceRangeValues(ranges, xRanges, int4RangeElementOps)), nil
+}
+
+// Gaps returns the ranges between the normalized ranges of src in order. The values before the first range and after
+// the last range are not included.
+func (src Int4multirange) Gaps() ([]Int4range, error) {
+	if src.Status != Present {
+		return nil, nil
+	}
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return nil, err
+	}
+
+	gaps := gapRangeValues(ranges, int4RangeElementOps)
+	result := make([]Int4range, len(gaps))
+	for i := range gaps {
+		result[i] = newInt4rangeFromRangeValue(gaps[i])
+	}
+	return result, nil
+}
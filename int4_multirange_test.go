@@ -1,10 +1,13 @@
 package pgtype_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInt4multirangeTranscode(t *testing.T) {
@@ -79,3 +82,185 @@ func TestInt4multirangeNormalize(t *testing.T) {
 		},
 	})
 }
+
+func mustParseInt4multirange(t testing.TB, s string) pgtype.Int4multirange {
+	var mr pgtype.Int4multirange
+	require.NoError(t, mr.DecodeText(nil, []byte(s)))
+	return mr
+}
+
+func int4multirangeString(t testing.TB, mr pgtype.Int4multirange) string {
+	buf, err := mr.EncodeText(nil, nil)
+	require.NoError(t, err)
+	return string(buf)
+}
+
+func TestInt4multirangeNormalizeRanges(t *testing.T) {
+	tests := []struct {
+		src    string
+		result string
+	}{
+		{src: "{}", result: "{}"},
+		{src: "{[3,3),(4,5)}", result: "{}"},
+		{src: "{(4,4)}", result: "{}"},
+		{src: "{(4,4],[1,2)}", result: "{[1,2)}"},
+		{src: "{[5,8),[1,3)}", result: "{[1,3),[5,8)}"},
+		{src: "{[1,3),[3,5)}", result: "{[1,5)}"},
+		{src: "{[1,3],(3,5]}", result: "{[1,6)}"},
+		{src: "{[1,4),[2,3),[6,7)}", result: "{[1,4),[6,7)}"},
+		{src: "{[10,),(,0),[0,10)}", result: "{(,)}"},
+	}
+
+	for i, tt := range tests {
+		mr, err := mustParseInt4multirange(t, tt.src).Normalize()
+		require.NoErrorf(t, err, "%d", i)
+		assert.Equalf(t, tt.result, int4multirangeString(t, mr), "%d: %s", i, tt.src)
+	}
+
+	// A normalized value is equal to the value decoded from the server.
+	mr, err := mustParseInt4multirange(t, "{[20,25],[1,14)}").Normalize()
+	require.NoError(t, err)
+	assert.Equal(t, mustParseInt4multirange(t, "{[1,14),[20,26)}"), mr)
+
+	_, err = pgtype.Int4multirange{Ranges: []pgtype.Int4range{{Status: pgtype.Null}}, Status: pgtype.Present}.Normalize()
+	require.Error(t, err)
+}
+
+func TestInt4multirangeOperations(t *testing.T) {
+	must := requireResult[bool](t)
+	mustMultirange := requireResult[pgtype.Int4multirange](t)
+
+	tests := []struct {
+		a, b       string
+		contains   bool
+		overlaps   bool
+		union      string
+		intersect  string
+		difference string
+	}{
+		{a: "{[1,10)}", b: "{[2,3),[5,6)}", contains: true, overlaps: true, union: "{[1,10)}", intersect: "{[2,3),[5,6)}", difference: "{[1,2),[3,5),[6,10)}"},
+		{a: "{[1,3),[5,8)}", b: "{[2,6)}", overlaps: true, union: "{[1,8)}", intersect: "{[2,3),[5,6)}", difference: "{[1,2),[6,8)}"},
+		{a: "{[1,3),[5,8)}", b: "{[3,5)}", union: "{[1,8)}", intersect: "{}", difference: "{[1,3),[5,8)}"},
+		{a: "{[1,3),[5,8)}", b: "{[0,20)}", overlaps: true, union: "{[0,20)}", intersect: "{[1,3),[5,8)}", difference: "{}"},
+		{a: "{(,)}", b: "{[0,1),[5,6)}", contains: true, overlaps: true, union: "{(,)}", intersect: "{[0,1),[5,6)}", difference: "{(,0),[1,5),[6,)}"},
+		{a: "{[1,3)}", b: "{}", contains: true, union: "{[1,3)}", intersect: "{}", difference: "{[1,3)}"},
+		{a: "{}", b: "{[1,3)}", union: "{[1,3)}", intersect: "{}", difference: "{}"},
+		{a: "{[1,3),[4,6)}", b: "{[2,5)}", overlaps: true, union: "{[1,6)}", intersect: "{[2,3),[4,5)}", difference: "{[1,2),[5,6)}"},
+	}
+
+	for i, tt := range tests {
+		a, b := mustParseInt4multirange(t, tt.a), mustParseInt4multirange(t, tt.b)
+		assert.Equalf(t, tt.contains, must(a.ContainsMultirange(b)), "%d: %s @> %s", i, tt.a, tt.b)
+		assert.Equalf(t, tt.overlaps, must(a.Overlaps(b)), "%d: %s && %s", i, tt.a, tt.b)
+		assert.Equalf(t, tt.overlaps, must(b.Overlaps(a)), "%d: %s && %s", i, tt.b, tt.a)
+		assert.Equalf(t, tt.union, int4multirangeString(t, mustMultirange(a.Union(b))), "%d: %s + %s", i, tt.a, tt.b)
+		assert.Equalf(t, tt.intersect, int4multirangeString(t, mustMultirange(a.Intersect(b))), "%d: %s * %s", i, tt.a, tt.b)
+		assert.Equalf(t, tt.difference, int4multirangeString(t, mustMultirange(a.Difference(b))), "%d: %s - %s", i, tt.a, tt.b)
+	}
+
+	mr := mustParseInt4multirange(t, "{[1,3),[5,8)}")
+	assert.True(t, must(mr.Contains(pgtype.Int4{Int: 2, Status: pgtype.Present})))
+	assert.False(t, must(mr.Contains(pgtype.Int4{Int: 3, Status: pgtype.Present})))
+	assert.True(t, must(mr.ContainsRange(mustParseInt4range(t, "[5,7]"))))
+	assert.False(t, must(mr.ContainsRange(mustParseInt4range(t, "[2,6)"))))
+	assert.True(t, must(mr.ContainsRange(mustParseInt4range(t, "empty"))))
+	assert.False(t, must(mr.IsEmpty()))
+	assert.True(t, must(mustParseInt4multirange(t, "{[3,3)}").IsEmpty()))
+
+	null := pgtype.Int4multirange{Status: pgtype.Null}
+	assert.Equal(t, pgtype.Null, mustMultirange(mr.Union(null)).Status)
+	assert.False(t, must(null.Overlaps(mr)))
+}
+
+func TestInt4multirangeInvalidRange(t *testing.T) {
+	valid := mustParseInt4multirange(t, "{[1,5)}")
+	for i, invalid := range []pgtype.Int4multirange{
+		{Ranges: []pgtype.Int4range{mustParseInt4range(t, "[1,2)"), {Status: pgtype.Null}}, Status: pgtype.Present},
+		{Ranges: []pgtype.Int4range{mustParseInt4range(t, "[1,2)"), mustParseInt4range(t, "[5,1)")}, Status: pgtype.Present},
+	} {
+		_, err := invalid.Normalize()
+		assert.Errorf(t, err, "%d", i)
+		_, err = invalid.IsEmpty()
+		assert.Errorf(t, err, "%d", i)
+		_, err = invalid.Contains(pgtype.Int4{Int: 1, Status: pgtype.Present})
+		assert.Errorf(t, err, "%d", i)
+		_, err = invalid.ContainsRange(mustParseInt4range(t, "[1,2)"))
+		assert.Errorf(t, err, "%d", i)
+		_, err = invalid.Gaps()
+		assert.Errorf(t, err, "%d", i)
+
+		for _, fn := range []func(a, b pgtype.Int4multirange) (bool, error){
+			pgtype.Int4multirange.ContainsMultirange,
+			pgtype.Int4multirange.Overlaps,
+		} {
+			_, err = fn(invalid, valid)
+			assert.Errorf(t, err, "%d", i)
+			_, err = fn(valid, invalid)
+			assert.Errorf(t, err, "%d", i)
+		}
+
+		for _, fn := range []func(a, b pgtype.Int4multirange) (pgtype.Int4multirange, error){
+			pgtype.Int4multirange.Union,
+			pgtype.Int4multirange.Intersect,
+			pgtype.Int4multirange.Difference,
+		} {
+			_, err = fn(invalid, valid)
+			assert.Errorf(t, err, "%d", i)
+			_, err = fn(valid, invalid)
+			assert.Errorf(t, err, "%d", i)
+		}
+	}
+}
+
+func TestInt4multirangeGaps(t *testing.T) {
+	tests := []struct {
+		src  string
+		gaps []string
+	}{
+		{src: "{}", gaps: []string{}},
+		{src: "{[1,3)}", gaps: []string{}},
+		{src: "{[5,8),[1,3),[10,)}", gaps: []string{"[3,5)", "[8,10)"}},
+		{src: "{(,0),[1,2)}", gaps: []string{"[0,1)"}},
+		{src: "{[1,3),[3,5)}", gaps: []string{}},
+	}
+
+	for i, tt := range tests {
+		ranges, err := mustParseInt4multirange(t, tt.src).Gaps()
+		require.NoErrorf(t, err, "%d", i)
+		gaps := []string{}
+		for _, g := range ranges {
+			gaps = append(gaps, int4rangeString(t, g))
+		}
+		assert.Equalf(t, tt.gaps, gaps, "%d: %s", i, tt.src)
+	}
+}
+
+func TestInt4multirangeOperationsMatchServer(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	must := requireResult[bool](t)
+	mustMultirange := requireResult[pgtype.Int4multirange](t)
+
+	multiranges := []string{"{}", "{(,)}", "{[1,3),[5,8)}", "{[2,6)}", "{[3,5)}", "{[0,1),[7,20)}", "{(,2),[4,)}", "{(4,4),[6,7]}"}
+
+	for _, as := range multiranges {
+		for _, bs := range multiranges {
+			a, b := mustParseInt4multirange(t, as), mustParseInt4multirange(t, bs)
+
+			var contains, overlaps bool
+			var union, intersect, difference string
+			err := conn.QueryRow(context.Background(),
+				"select $1::int4multirange @> $2::int4multirange, $1::int4multirange && $2::int4multirange, ($1::int4multirange + $2::int4multirange)::text, ($1::int4multirange * $2::int4multirange)::text, ($1::int4multirange - $2::int4multirange)::text",
+				as, bs,
+			).Scan(&contains, &overlaps, &union, &intersect, &difference)
+			require.NoError(t, err)
+
+			assert.Equalf(t, contains, must(a.ContainsMultirange(b)), "%s @> %s", as, bs)
+			assert.Equalf(t, overlaps, must(a.Overlaps(b)), "%s && %s", as, bs)
+			assert.Equalf(t, union, int4multirangeString(t, mustMultirange(a.Union(b))), "%s + %s", as, bs)
+			assert.Equalf(t, intersect, int4multirangeString(t, mustMultirange(a.Intersect(b))), "%s * %s", as, bs)
+			assert.Equalf(t, difference, int4multirangeString(t, mustMultirange(a.Difference(b))), "%s - %s", as, bs)
+		}
+	}
+}
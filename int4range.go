@@ -265,3 +265,182 @@ func (dst *Int4range) Scan(src interface{}) error {
 func (src Int4range) Value() (driver.Value, error) {
 	return EncodeValueText(src)
 }
+
+func (src Int4range) rangeValue() (rangeValue[Int4], error) {
+	return newRangeValue(src.Lower, src.Upper, src.LowerType, src.UpperType, int4RangeElementOps)
+}
+
+func newInt4rangeFromRangeValue(r rangeValue[Int4]) Int4range {
+	dst := Int4range{Status: Present}
+	dst.LowerType, dst.UpperType = r.boundTypes()
+	if dst.LowerType == Inclusive || dst.LowerType == Exclusive {
+		dst.Lower = r.lower.value
+	}
+	if dst.UpperType == Inclusive || dst.UpperType == Exclusive {
+		dst.Upper = r.upper.value
+	}
+	return dst
+}
+
+// Canonicalize returns src in the canonical form PostgreSQL uses. A range that contains no values is empty and a
+// range of a discrete element type has an inclusive lower bound and an exclusive upper bound.
+func (src Int4range) Canonicalize() (Int4range, error) {
+	if src.Status != Present {
+		return Int4range{Status: src.Status}, nil
+	}
+
+	r, err := src.rangeValue()
+	if err != nil {
+		return Int4range{}, err
+	}
+	return newInt4rangeFromRangeValue(r), nil
+}
+
+// rangeValuePair returns the range values of src and x.
+func (src Int4range) rangeValuePair(x Int4range) (rangeValue[Int4], rangeValue[Int4], error) {
+	r, err := src.rangeValue()
+	if err != nil {
+		return r, r, err
+	}
+	xr, err := x.rangeValue()
+	return r, xr, err
+}
+
+// IsEmpty reports whether src contains no values. It is an error if src is not a valid range.
+func (src Int4range) IsEmpty() (bool, error) {
+	if src.Status != Present {
+		return false, nil
+	}
+	r, err := src.rangeValue()
+	if err != nil {
+		return false, err
+	}
+	return r.empty, nil
+}
+
+// Contains reports whether src contains elem like the PostgreSQL @> operator. It is an error if src is not a valid
+// range.
+func (src Int4range) Contains(elem Int4) (bool, error) {
+	if src.Status != Present || elem.Status != Present {
+		return false, nil
+	}
+	r, err := src.rangeValue()
+	if err != nil {
+		return false, err
+	}
+	return r.containsElem(elem, int4RangeElementOps), nil
+}
+
+// ContainsRange reports whether src contains x like the PostgreSQL @> operator. It is an error if either range is
+// not a valid range.
+func (src Int4range) ContainsRange(x Int4range) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.containsRange(xr, int4RangeElementOps), nil
+}
+
+// Overlaps reports whether src and x have values in common like the PostgreSQL && operator. It is an error if either
+// range is not a valid range.
+func (src Int4range) Overlaps(x Int4range) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.overlaps(xr, int4RangeElementOps), nil
+}
+
+// Adjacent reports whether src and x are adjacent like the PostgreSQL -|- operator. It is an error if either range is
+// not a valid range.
+func (src Int4range) Adjacent(x Int4range) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.adjacent(xr, int4RangeElementOps), nil
+}
+
+// StrictlyLeftOf reports whether all values of src are less than all values of x like the PostgreSQL << operator. It
+// is an error if either range is not a valid range.
+func (src Int4range) StrictlyLeftOf(x Int4range) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.before(xr, int4RangeElementOps), nil
+}
+
+// StrictlyRightOf reports whether all values of src are greater than all values of x like the PostgreSQL >>
+// operator. It is an error if either range is not a valid range.
+func (src Int4range) StrictlyRightOf(x Int4range) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.after(xr, int4RangeElementOps), nil
+}
+
+// Union returns the union of src and x like the PostgreSQL + operator. It is an error if the ranges neither overlap
+// nor are adjacent. If either range is not Present the result is Null.
+func (src Int4range) Union(x Int4range) (Int4range, error) {
+	if src.Status != Present || x.Status != Present {
+		return Int4range{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Int4range{}, err
+	}
+
+	result, err := r.union(xr, int4RangeElementOps)
+	if err != nil {
+		return Int4range{}, err
+	}
+	return newInt4rangeFromRangeValue(result), nil
+}
+
+// Intersect returns the intersection of src and x like the PostgreSQL * operator. If either range is not Present the
+// result is Null. It is an error if either range is not a valid range.
+func (src Int4range) Intersect(x Int4range) (Int4range, error) {
+	if src.Status != Present || x.Status != Present {
+		return Int4range{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Int4range{}, err
+	}
+	return newInt4rangeFromRangeValue(r.intersect(xr, int4RangeElementOps)), nil
+}
+
+// Difference returns the values of src that are not in x like the PostgreSQL - operator. It is an error if x is
+// strictly inside src so the result would be two ranges. If either range is not Present the result is Null.
+func (src Int4range) Difference(x Int4range) (Int4range, error) {
+	if src.Status != Present || x.Status != Present {
+		return Int4range{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Int4range{}, err
+	}
+
+	result, err := r.difference(xr, int4RangeElementOps)
+	if err != nil {
+		return Int4range{}, err
+	}
+	return newInt4rangeFromRangeValue(result), nil
+}
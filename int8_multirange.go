@@ -237,3 +237,193 @@ func (dst *Int8multirange) Scan(src interface{}) error {
 func (src Int8multirange) Value() (driver.Value, error) {
 	return EncodeValueText(src)
 }
+
+// rangeValues returns the normalized range values of src. It is an error if a range is not Present or is not a valid
+// range.
+func (src Int8multirange) rangeValues() ([]rangeValue[Int8], error) {
+	ranges := make([]rangeValue[Int8], len(src.Ranges))
+	for i, r := range src.Ranges {
+		if r.Status != Present {
+			return nil, fmt.Errorf("range %d is not present", i)
+		}
+
+		var err error
+		ranges[i], err = r.rangeValue()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return normalizeRangeValues(ranges, int8RangeElementOps), nil
+}
+
+// rangeValuePair returns the normalized range values of src and x.
+func (src Int8multirange) rangeValuePair(x Int8multirange) ([]rangeValue[Int8], []rangeValue[Int8], error) {
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return nil, nil, err
+	}
+	xRanges, err := x.rangeValues()
+	if err != nil {
+		return nil, nil, err
+	}
+	return ranges, xRanges, nil
+}
+
+func newInt8multirangeFromRangeValues(ranges []rangeValue[Int8]) Int8multirange {
+	dst := Int8multirange{Status: Present}
+	if len(ranges) > 0 {
+		dst.Ranges = make([]Int8range, len(ranges))
+		for i := range ranges {
+			dst.Ranges[i] = newInt8rangeFromRangeValue(ranges[i])
+		}
+	}
+	return dst
+}
+
+// Normalize returns src in the form PostgreSQL uses. Empty ranges are removed, overlapping and adjacent ranges are
+// merged, and the ranges are canonicalized and sorted. It is an error if a range is not Present or is not a valid
+// range. The other operations on Int8multirange have the same requirements.
+func (src Int8multirange) Normalize() (Int8multirange, error) {
+	if src.Status != Present {
+		return Int8multirange{Status: src.Status}, nil
+	}
+
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return Int8multirange{}, err
+	}
+	return newInt8multirangeFromRangeValues(ranges), nil
+}
+
+// IsEmpty reports whether src contains no values.
+func (src Int8multirange) IsEmpty() (bool, error) {
+	if src.Status != Present {
+		return false, nil
+	}
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return false, err
+	}
+	return len(ranges) == 0, nil
+}
+
+// Contains reports whether src contains elem like the PostgreSQL @> operator.
+func (src Int8multirange) Contains(elem Int8) (bool, error) {
+	if src.Status != Present || elem.Status != Present {
+		return false, nil
+	}
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return false, err
+	}
+	for _, r := range ranges {
+		if r.containsElem(elem, int8RangeElementOps) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ContainsRange reports whether src contains r like the PostgreSQL @> operator.
+func (src Int8multirange) ContainsRange(r Int8range) (bool, error) {
+	if src.Status != Present || r.Status != Present {
+		return false, nil
+	}
+	return src.ContainsMultirange(Int8multirange{Ranges: []Int8range{r}, Status: Present})
+}
+
+// ContainsMultirange reports whether src contains x like the PostgreSQL @> operator.
+func (src Int8multirange) ContainsMultirange(x Int8multirange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+
+	for _, xr := range xRanges {
+		contained := false
+		for _, r := range ranges {
+			if r.containsRange(xr, int8RangeElementOps) {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Overlaps reports whether src and x have values in common like the PostgreSQL && operator.
+func (src Int8multirange) Overlaps(x Int8multirange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return len(intersectRangeValues(ranges, xRanges, int8RangeElementOps)) > 0, nil
+}
+
+// Union returns the normalized union of src and x like the PostgreSQL + operator. If either multirange is not Present
+// the result is Null.
+func (src Int8multirange) Union(x Int8multirange) (Int8multirange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Int8multirange{Status: Null}, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return Int8multirange{}, err
+	}
+	return newInt8multirangeFromRangeValues(unionRangeValues(ranges, xRanges, int8RangeElementOps)), nil
+}
+
+// Intersect returns the normalized intersection of src and x like the PostgreSQL * operator. If either multirange is
+// not Present the result is Null.
+func (src Int8multirange) Intersect(x Int8multirange) (Int8multirange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Int8multirange{Status: Null}, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return Int8multirange{}, err
+	}
+	return newInt8multirangeFromRangeValues(intersectRangeValues(ranges, xRanges, int8RangeElementOps)), nil
+}
+
+// Difference returns the normalized values of src that are not in x like the PostgreSQL - operator. If either
+// multirange is not Present the result is Null.
+func (src Int8multirange) Difference(x Int8multirange) (Int8multirange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Int8multirange{Status: Null}, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return Int8multirange{}, err
+	}
+	return newInt8multirangeFromRangeValues(differenceRangeValues(ranges, xRanges, int8RangeElementOps)), nil
+}
+
+// Gaps returns the ranges between the normalized ranges of src in order. The values before the first range and after
+// the last range are not included.
+func (src Int8multirange) Gaps() ([]Int8range, error) {
+	if src.Status != Present {
+		return nil, nil
+	}
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return nil, err
+	}
+
+	gaps := gapRangeValues(ranges, int8RangeElementOps)
+	result := make([]Int8range, len(gaps))
+	for i := range gaps {
+		result[i] = newInt8rangeFromRangeValue(gaps[i])
+	}
+	return result, nil
+}
@@ -265,3 +265,182 @@ func (dst *Int8range) Scan(src interface{}) error {
 func (src Int8range) Value() (driver.Value, error) {
 	return EncodeValueText(src)
 }
+
+func (src Int8range) rangeValue() (rangeValue[Int8], error) {
+	return newRangeValue(src.Lower, src.Upper, src.LowerType, src.UpperType, int8RangeElementOps)
+}
+
+func newInt8rangeFromRangeValue(r rangeValue[Int8]) Int8range {
+	dst := Int8range{Status: Present}
+	dst.LowerType, dst.UpperType = r.boundTypes()
+	if dst.LowerType == Inclusive || dst.LowerType == Exclusive {
+		dst.Lower = r.lower.value
+	}
+	if dst.UpperType == Inclusive || dst.UpperType == Exclusive {
+		dst.Upper = r.upper.value
+	}
+	return dst
+}
+
+// Canonicalize returns src in the canonical form PostgreSQL uses. A range that contains no values is empty and a
+// range of a discrete element type has an inclusive lower bound and an exclusive upper bound.
+func (src Int8range) Canonicalize() (Int8range, error) {
+	if src.Status != Present {
+		return Int8range{Status: src.Status}, nil
+	}
+
+	r, err := src.rangeValue()
+	if err != nil {
+		return Int8range{}, err
+	}
+	return newInt8rangeFromRangeValue(r), nil
+}
+
+// rangeValuePair returns the range values of src and x.
+func (src Int8range) rangeValuePair(x Int8range) (rangeValue[Int8], rangeValue[Int8], error) {
+	r, err := src.rangeValue()
+	if err != nil {
+		return r, r, err
+	}
+	xr, err := x.rangeValue()
+	return r, xr, err
+}
+
+// IsEmpty reports whether src contains no values. It is an error if src is not a valid range.
+func (src Int8range) IsEmpty() (bool, error) {
+	if src.Status != Present {
+		return false, nil
+	}
+	r, err := src.rangeValue()
+	if err != nil {
+		return false, err
+	}
+	return r.empty, nil
+}
+
+// Contains reports whether src contains elem like the PostgreSQL @> operator. It is an error if src is not a valid
+// range.
+func (src Int8range) Contains(elem Int8) (bool, error) {
+	if src.Status != Present || elem.Status != Present {
+		return false, nil
+	}
+	r, err := src.rangeValue()
+	if err != nil {
+		return false, err
+	}
+	return r.containsElem(elem, int8RangeElementOps), nil
+}
+
+// ContainsRange reports whether src contains x like the PostgreSQL @> operator. It is an error if either range is
+// not a valid range.
+func (src Int8range) ContainsRange(x Int8range) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.containsRange(xr, int8RangeElementOps), nil
+}
+
+// Overlaps reports whether src and x have values in common like the PostgreSQL && operator. It is an error if either
+// range is not a valid range.
+func (src Int8range) Overlaps(x Int8range) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.overlaps(xr, int8RangeElementOps), nil
+}
+
+// Adjacent reports whether src and x are adjacent like the PostgreSQL -|- operator. It is an error if either range is
+// not a valid range.
+func (src Int8range) Adjacent(x Int8range) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.adjacent(xr, int8RangeElementOps), nil
+}
+
+// StrictlyLeftOf reports whether all values of src are less than all values of x like the PostgreSQL << operator. It
+// is an error if either range is not a valid range.
+func (src Int8range) StrictlyLeftOf(x Int8range) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.before(xr, int8RangeElementOps), nil
+}
+
+// StrictlyRightOf reports whether all values of src are greater than all values of x like the PostgreSQL >>
+// operator. It is an error if either range is not a valid range.
+func (src Int8range) StrictlyRightOf(x Int8range) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.after(xr, int8RangeElementOps), nil
+}
+
+// Union returns the union of src and x like the PostgreSQL + operator. It is an error if the ranges neither overlap
+// nor are adjacent. If either range is not Present the result is Null.
+func (src Int8range) Union(x Int8range) (Int8range, error) {
+	if src.Status != Present || x.Status != Present {
+		return Int8range{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Int8range{}, err
+	}
+
+	result, err := r.union(xr, int8RangeElementOps)
+	if err != nil {
+		return Int8range{}, err
+	}
+	return newInt8rangeFromRangeValue(result), nil
+}
+
+// Intersect returns the intersection of src and x like the PostgreSQL * operator. If either range is not Present the
+// result is Null. It is an error if either range is not a valid range.
+func (src Int8range) Intersect(x Int8range) (Int8range, error) {
+	if src.Status != Present || x.Status != Present {
+		return Int8range{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Int8range{}, err
+	}
+	return newInt8rangeFromRangeValue(r.intersect(xr, int8RangeElementOps)), nil
+}
+
+// Difference returns the values of src that are not in x like the PostgreSQL - operator. It is an error if x is
+// strictly inside src so the result would be two ranges. If either range is not Present the result is Null.
+func (src Int8range) Difference(x Int8range) (Int8range, error) {
+	if src.Status != Present || x.Status != Present {
+		return Int8range{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Int8range{}, err
+	}
+
+	result, err := r.difference(xr, int8RangeElementOps)
+	if err != nil {
+		return Int8range{}, err
+	}
+	return newInt8rangeFromRangeValue(result), nil
+}
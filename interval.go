@@ -3,7 +3,9 @@ package pgtype
 import (
 	"database/sql/driver"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -19,6 +21,18 @@ const (
 	microsecondsPerMonth  = 30 * microsecondsPerDay
 )
 
+// Interval type modifier field masks and defaults as used by PostgreSQL in e.g. interval day to second(3).
+const (
+	intervalMaskMonth     = 1 << 1
+	intervalMaskYear      = 1 << 2
+	intervalMaskDay       = 1 << 3
+	intervalMaskHour      = 1 << 10
+	intervalMaskMinute    = 1 << 11
+	intervalMaskSecond    = 1 << 12
+	intervalFullRange     = 0x7fff
+	intervalFullPrecision = 0xffff
+)
+
 type Interval struct {
 	Microseconds int64
 	Days         int32
@@ -26,6 +40,47 @@ type Interval struct {
 	Status       Status
 }
 
+// ApplyTypeModifier truncates dst to the fields of an interval typmod such as interval day to hour and rounds it half
+// away from zero to the fractional second precision of a typmod such as interval second(0).
+func (dst *Interval) ApplyTypeModifier(typmod int32) error {
+	if dst.Status != Present || typmod < 0 {
+		return nil
+	}
+
+	fields := (typmod >> 16) & intervalFullRange
+	precision := typmod & intervalFullPrecision
+	if precision != intervalFullPrecision && precision > 6 {
+		return fmt.Errorf("interval(%d) precision must be between 0 and 6", precision)
+	}
+
+	switch fields {
+	case intervalFullRange:
+	case intervalMaskYear:
+		dst.Months = dst.Months / 12 * 12
+		dst.Days = 0
+		dst.Microseconds = 0
+	case intervalMaskMonth, intervalMaskYear | intervalMaskMonth:
+		dst.Days = 0
+		dst.Microseconds = 0
+	case intervalMaskDay:
+		dst.Microseconds = 0
+	case intervalMaskHour, intervalMaskDay | intervalMaskHour:
+		dst.Microseconds = dst.Microseconds / microsecondsPerHour * microsecondsPerHour
+	case intervalMaskMinute, intervalMaskHour | intervalMaskMinute, intervalMaskDay | intervalMaskHour | intervalMaskMinute:
+		dst.Microseconds = dst.Microseconds / microsecondsPerMinute * microsecondsPerMinute
+	case intervalMaskSecond, intervalMaskMinute | intervalMaskSecond, intervalMaskHour | intervalMaskMinute | intervalMaskSecond,
+		intervalMaskDay | intervalMaskHour | intervalMaskMinute | intervalMaskSecond:
+	default:
+		return fmt.Errorf("unrecognized interval typmod: %d", typmod)
+	}
+
+	if precision != intervalFullPrecision {
+		dst.Microseconds = roundMicroseconds(dst.Microseconds, precision)
+	}
+
+	return nil
+}
+
 func (dst *Interval) Set(src interface{}) error {
 	if src == nil {
 		*dst = Interval{Status: Null}
@@ -68,8 +123,11 @@ func (src *Interval) AssignTo(dst interface{}) error {
 	case Present:
 		switch v := dst.(type) {
 		case *time.Duration:
-			us := int64(src.Months)*microsecondsPerMonth + int64(src.Days)*microsecondsPerDay + src.Microseconds
-			*v = time.Duration(us) * time.Microsecond
+			d, err := src.Duration(IntervalDurationJustify)
+			if err != nil {
+				return err
+			}
+			*v = d
 			return nil
 		default:
 			if nextDst, retry := GetAssignToDstType(dst); retry {
@@ -84,87 +142,532 @@ func (src *Interval) AssignTo(dst interface{}) error {
 	return fmt.Errorf("cannot decode %#v into %T", src, dst)
 }
 
+var errIntervalDurationOverflow = errors.New("interval overflows time.Duration")
+
+var errIntervalOutOfRange = errors.New("interval out of range")
+
+// IntervalDurationPolicy controls how the months and days of an Interval are converted to a time.Duration.
+type IntervalDurationPolicy int8
+
+const (
+	// IntervalDurationJustify treats a month as 30 days and a day as 24 hours like PostgreSQL's justify_interval. This
+	// is the policy used by AssignTo.
+	IntervalDurationJustify IntervalDurationPolicy = iota
+
+	// IntervalDurationStrict refuses to convert an interval with months or days as their length is not fixed.
+	IntervalDurationStrict
+)
+
+// Duration converts src to a time.Duration using policy. An error is returned if src is not present, if policy does
+// not allow the months or days of src or if the result does not fit in a time.Duration (about ±292 years).
+func (src *Interval) Duration(policy IntervalDurationPolicy) (time.Duration, error) {
+	if src.Status != Present {
+		return 0, fmt.Errorf("cannot convert %v to time.Duration", src.Status)
+	}
+
+	var us int64
+	switch policy {
+	case IntervalDurationJustify:
+		var ok bool
+		us, ok = mulInt64(int64(src.Months), microsecondsPerMonth)
+		if ok {
+			var days int64
+			days, ok = mulInt64(int64(src.Days), microsecondsPerDay)
+			if ok {
+				us, ok = addInt64(us, days)
+			}
+		}
+		if ok {
+			us, ok = addInt64(us, src.Microseconds)
+		}
+		if !ok {
+			return 0, errIntervalDurationOverflow
+		}
+	case IntervalDurationStrict:
+		if src.Months != 0 || src.Days != 0 {
+			return 0, fmt.Errorf("cannot convert interval with %d months and %d days to time.Duration", src.Months, src.Days)
+		}
+		us = src.Microseconds
+	default:
+		return 0, fmt.Errorf("unknown interval duration policy: %d", policy)
+	}
+
+	ns, ok := mulInt64(us, int64(time.Microsecond))
+	if !ok {
+		return 0, errIntervalDurationOverflow
+	}
+
+	return time.Duration(ns), nil
+}
+
+// DurationFrom converts src to a time.Duration by adding it to t using calendar arithmetic in t's location. Months and
+// days have their actual length at that point in time, so one month from January 31 ends on the last day of February,
+// and a day that crosses a daylight saving time transition may be 23 or 25 hours. An error is returned if src is not
+// present or if the result does not fit in a time.Duration.
+func (src *Interval) DurationFrom(t time.Time) (time.Duration, error) {
+	if src.Status != Present {
+		return 0, fmt.Errorf("cannot convert %v to time.Duration", src.Status)
+	}
+
+	end := src.AddTo(t)
+	d := end.Sub(t)
+
+	// time.Time.Sub saturates at the minimum and maximum time.Duration.
+	if !t.Add(d).Equal(end) {
+		return 0, errIntervalDurationOverflow
+	}
+
+	return d, nil
+}
+
+// AddTo returns t plus src. Months are added first in t's location and the day of the month is clamped to the last day
+// of the resulting month, so 2023-01-31 plus one month is 2023-02-28. Then days are added in t's location and finally
+// microseconds are added as elapsed time. This matches PostgreSQL's timestamptz + interval. t is returned unchanged if
+// src is not present.
+func (src *Interval) AddTo(t time.Time) time.Time {
+	if src.Status != Present {
+		return t
+	}
+
+	if src.Months != 0 {
+		year, month, day := t.Date()
+		// Normalize the month with time.Date before clamping the day.
+		first := time.Date(year, month+time.Month(src.Months), 1, 0, 0, 0, 0, t.Location())
+		year, month, _ = first.Date()
+		if lastDay := daysInMonth(year, month); day > lastDay {
+			day = lastDay
+		}
+		hour, min, sec := t.Clock()
+		t = time.Date(year, month, day, hour, min, sec, t.Nanosecond(), t.Location())
+	}
+	t = t.AddDate(0, 0, int(src.Days))
+
+	// Adding the microseconds in parts avoids overflowing time.Duration for intervals of more than 292 years.
+	us := src.Microseconds
+	const maxMicroseconds = int64(1<<63-1) / int64(time.Microsecond)
+	for us > maxMicroseconds {
+		t = t.Add(time.Duration(maxMicroseconds) * time.Microsecond)
+		us -= maxMicroseconds
+	}
+	for us < -maxMicroseconds {
+		t = t.Add(-time.Duration(maxMicroseconds) * time.Microsecond)
+		us += maxMicroseconds
+	}
+
+	return t.Add(time.Duration(us) * time.Microsecond)
+}
+
+// daysInMonth returns the number of days in month of year.
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func addInt64(a, b int64) (int64, bool) {
+	if (b > 0 && a > math.MaxInt64-b) || (b < 0 && a < math.MinInt64-b) {
+		return 0, false
+	}
+	return a + b, true
+}
+
+func mulInt64(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	c := a * b
+	if c/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return c, true
+}
+
 func (dst *Interval) DecodeText(ci *ConnInfo, src []byte) error {
 	if src == nil {
 		*dst = Interval{Status: Null}
 		return nil
 	}
 
+	// The output of every IntervalStyle can be recognized without knowing the server setting.
+	var interval Interval
+	var err error
+	s := string(src)
+	switch {
+	case strings.HasPrefix(s, "@"):
+		interval, err = parseIntervalPostgresVerbose(s)
+	case strings.HasPrefix(s, "P") || strings.HasPrefix(s, "-P"):
+		interval, err = parseIntervalISO8601(s)
+	case strings.ContainsAny(s, "abcdefghijklmnopqrstuvwxyz"):
+		interval, err = parseIntervalPostgres(s)
+	default:
+		interval, err = parseIntervalSQLStandard(s)
+	}
+	if err != nil {
+		return err
+	}
+
+	*dst = interval
+	return nil
+}
+
+// parseIntervalPostgres parses the postgres IntervalStyle. e.g. 1 year 2 mons -3 days +04:05:06.789
+func parseIntervalPostgres(src string) (Interval, error) {
 	var microseconds int64
 	var days int32
 	var months int32
 
-	parts := strings.Split(string(src), " ")
+	parts := strings.Split(src, " ")
 
 	for i := 0; i < len(parts)-1; i += 2 {
 		scalar, err := strconv.ParseInt(parts[i], 10, 64)
 		if err != nil {
-			return fmt.Errorf("bad interval format")
+			return Interval{}, fmt.Errorf("bad interval format")
 		}
 
 		switch parts[i+1] {
 		case "year", "years":
-			months += int32(scalar * 12)
+			err = addIntervalField(&months, scalar, 12)
 		case "mon", "mons":
-			months += int32(scalar)
+			err = addIntervalField(&months, scalar, 1)
 		case "day", "days":
-			days = int32(scalar)
+			err = addIntervalField(&days, scalar, 1)
+		}
+		if err != nil {
+			return Interval{}, err
 		}
 	}
 
 	if len(parts)%2 == 1 {
-		timeParts := strings.SplitN(parts[len(parts)-1], ":", 3)
-		if len(timeParts) != 3 {
-			return fmt.Errorf("bad interval format")
+		var err error
+		microseconds, err = parseIntervalTime(parts[len(parts)-1])
+		if err != nil {
+			return Interval{}, err
+		}
+	}
+
+	return Interval{Months: months, Days: days, Microseconds: microseconds, Status: Present}, nil
+}
+
+// addIntervalField adds scalar units of multiplier to the months or days field. Like PostgreSQL it returns an interval
+// out of range error instead of overflowing the int32 field.
+func addIntervalField(field *int32, scalar, multiplier int64) error {
+	if scalar < math.MinInt32 || scalar > math.MaxInt32 {
+		return errIntervalOutOfRange
+	}
+
+	n := int64(*field) + scalar*multiplier
+	if n < math.MinInt32 || n > math.MaxInt32 {
+		return errIntervalOutOfRange
+	}
+
+	*field = int32(n)
+	return nil
+}
+
+// parseIntervalTime parses a signed [-]h:mm:ss[.ffffff] time field into microseconds.
+func parseIntervalTime(src string) (int64, error) {
+	timeParts := strings.SplitN(src, ":", 3)
+	if len(timeParts) != 3 || len(timeParts[0]) == 0 {
+		return 0, fmt.Errorf("bad interval format")
+	}
+
+	var negative bool
+	switch timeParts[0][0] {
+	case '-':
+		negative = true
+		timeParts[0] = timeParts[0][1:]
+	case '+':
+		timeParts[0] = timeParts[0][1:]
+	}
+
+	hours, err := strconv.ParseInt(timeParts[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("bad interval hour format: %s", timeParts[0])
+	}
+
+	minutes, err := strconv.ParseInt(timeParts[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("bad interval minute format: %s", timeParts[1])
+	}
+
+	seconds, err := parseIntervalSeconds(timeParts[2])
+	if err != nil {
+		return 0, err
+	}
+
+	microseconds := hours*microsecondsPerHour + minutes*microsecondsPerMinute + seconds
+	if negative {
+		microseconds = -microseconds
+	}
+
+	return microseconds, nil
+}
+
+// parseIntervalSeconds parses a signed seconds value with an optional fraction of up to 6 digits into microseconds.
+func parseIntervalSeconds(src string) (int64, error) {
+	var negative bool
+	if len(src) > 0 && (src[0] == '-' || src[0] == '+') {
+		negative = src[0] == '-'
+		src = src[1:]
+	}
+
+	secondParts := strings.SplitN(src, ".", 2)
+
+	seconds, err := strconv.ParseUint(secondParts[0], 10, 63)
+	if err != nil {
+		return 0, fmt.Errorf("bad interval second format: %s", secondParts[0])
+	}
+
+	var uSeconds int64
+	if len(secondParts) == 2 {
+		if len(secondParts[1]) > 6 {
+			secondParts[1] = secondParts[1][:6]
+		}
+
+		uSeconds, err = strconv.ParseInt(secondParts[1], 10, 64)
+		if err != nil || secondParts[1][0] == '-' || secondParts[1][0] == '+' {
+			return 0, fmt.Errorf("bad interval decimal format: %s", secondParts[1])
 		}
 
-		var negative bool
-		if timeParts[0][0] == '-' {
-			negative = true
-			timeParts[0] = timeParts[0][1:]
+		for i := 0; i < 6-len(secondParts[1]); i++ {
+			uSeconds *= 10
+		}
+	}
+
+	microseconds := int64(seconds)*microsecondsPerSecond + uSeconds
+	if negative {
+		microseconds = -microseconds
+	}
+
+	return microseconds, nil
+}
+
+// parseIntervalPostgresVerbose parses the postgres_verbose IntervalStyle. e.g. @ 1 year 2 mons -3 days 4 hours 5 mins
+// 6.789 secs ago
+func parseIntervalPostgresVerbose(src string) (Interval, error) {
+	fields := strings.Fields(strings.TrimPrefix(src, "@"))
+
+	ago := len(fields) > 0 && fields[len(fields)-1] == "ago"
+	if ago {
+		fields = fields[:len(fields)-1]
+	}
+
+	if len(fields) == 1 && fields[0] == "0" {
+		return Interval{Status: Present}, nil
+	}
+
+	if len(fields)%2 != 0 {
+		return Interval{}, fmt.Errorf("bad interval format")
+	}
+
+	var microseconds int64
+	var days int32
+	var months int32
+
+	for i := 0; i < len(fields); i += 2 {
+		unit := fields[i+1]
+		if unit == "sec" || unit == "secs" {
+			seconds, err := parseIntervalSeconds(fields[i])
+			if err != nil {
+				return Interval{}, err
+			}
+			microseconds += seconds
+			continue
 		}
 
-		hours, err := strconv.ParseInt(timeParts[0], 10, 64)
+		scalar, err := strconv.ParseInt(fields[i], 10, 64)
 		if err != nil {
-			return fmt.Errorf("bad interval hour format: %s", timeParts[0])
+			return Interval{}, fmt.Errorf("bad interval format")
 		}
 
-		minutes, err := strconv.ParseInt(timeParts[1], 10, 64)
+		switch unit {
+		case "year", "years":
+			err = addIntervalField(&months, scalar, 12)
+		case "mon", "mons":
+			err = addIntervalField(&months, scalar, 1)
+		case "day", "days":
+			err = addIntervalField(&days, scalar, 1)
+		case "hour", "hours":
+			microseconds += scalar * microsecondsPerHour
+		case "min", "mins":
+			microseconds += scalar * microsecondsPerMinute
+		default:
+			return Interval{}, fmt.Errorf("bad interval unit: %s", unit)
+		}
 		if err != nil {
-			return fmt.Errorf("bad interval minute format: %s", timeParts[1])
+			return Interval{}, err
+		}
+	}
+
+	if ago {
+		if days == math.MinInt32 || months == math.MinInt32 {
+			return Interval{}, errIntervalOutOfRange
 		}
+		microseconds, days, months = -microseconds, -days, -months
+	}
 
-		secondParts := strings.SplitN(timeParts[2], ".", 2)
+	return Interval{Months: months, Days: days, Microseconds: microseconds, Status: Present}, nil
+}
 
-		seconds, err := strconv.ParseInt(secondParts[0], 10, 64)
-		if err != nil {
-			return fmt.Errorf("bad interval second format: %s", secondParts[0])
+// parseIntervalSQLStandard parses the sql_standard IntervalStyle. e.g. 1-2, 3 4:05:06 or -1-2 +3 -4:05:06. As in
+// PostgreSQL, a leading minus sign applies to every field unless another field has an explicit sign.
+func parseIntervalSQLStandard(src string) (Interval, error) {
+	fields := strings.Fields(src)
+	if len(fields) == 0 {
+		return Interval{}, fmt.Errorf("bad interval format")
+	}
+
+	negateAll := fields[0][0] == '-'
+	for _, f := range fields[1:] {
+		if f[0] == '-' || f[0] == '+' {
+			negateAll = false
+		}
+	}
+
+	var microseconds int64
+	var days int32
+	var months int32
+
+	for i, f := range fields {
+		negative := negateAll
+		if f[0] == '-' || f[0] == '+' {
+			negative = negative || f[0] == '-'
+			f = f[1:]
 		}
 
-		var uSeconds int64
-		if len(secondParts) == 2 {
-			uSeconds, err = strconv.ParseInt(secondParts[1], 10, 64)
+		var err error
+		switch {
+		case strings.Contains(f, ":"):
+			microseconds, err = parseIntervalTime(f)
+			if negative {
+				microseconds = -microseconds
+			}
+		case strings.Contains(f, "-"):
+			yearMonth := strings.SplitN(f, "-", 2)
+			var years, mons int64
+			years, err = strconv.ParseInt(yearMonth[0], 10, 64)
+			if err == nil {
+				mons, err = strconv.ParseInt(yearMonth[1], 10, 64)
+			}
+			if err != nil {
+				break
+			}
+			if negative {
+				years, mons = -years, -mons
+			}
+			months = 0
+			if err := addIntervalField(&months, years, 12); err != nil {
+				return Interval{}, err
+			}
+			if err := addIntervalField(&months, mons, 1); err != nil {
+				return Interval{}, err
+			}
+		case i < len(fields)-1:
+			// A number followed by another field is days.
+			var n int64
+			n, err = strconv.ParseInt(f, 10, 64)
 			if err != nil {
-				return fmt.Errorf("bad interval decimal format: %s", secondParts[1])
+				break
+			}
+			if negative {
+				n = -n
+			}
+			days = 0
+			if err := addIntervalField(&days, n, 1); err != nil {
+				return Interval{}, err
+			}
+		default:
+			// A trailing number is seconds.
+			microseconds, err = parseIntervalSeconds(f)
+			if negative {
+				microseconds = -microseconds
+			}
+		}
+		if err != nil {
+			return Interval{}, fmt.Errorf("bad interval format: %s", src)
+		}
+	}
+
+	return Interval{Months: months, Days: days, Microseconds: microseconds, Status: Present}, nil
+}
+
+// parseIntervalISO8601 parses the iso_8601 IntervalStyle format with designators. e.g. P1Y2M3DT4H5M6.789S or
+// P-1Y-2M3DT-4H-5M-6S. Weeks and a leading minus sign that applies to every field are also accepted.
+func parseIntervalISO8601(src string) (Interval, error) {
+	s := src
+	negateAll := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	s = strings.TrimPrefix(s, "P")
+	if s == "" {
+		return Interval{}, fmt.Errorf("bad interval format: %s", src)
+	}
+
+	var microseconds int64
+	var days int32
+	var months int32
+
+	inTime := false
+	for len(s) > 0 {
+		if s[0] == 'T' {
+			if inTime {
+				return Interval{}, fmt.Errorf("bad interval format: %s", src)
 			}
+			inTime = true
+			s = s[1:]
+			continue
+		}
+
+		n := strings.IndexAny(s, "YMWDHS")
+		if n <= 0 {
+			return Interval{}, fmt.Errorf("bad interval format: %s", src)
+		}
+		number, designator := s[:n], s[n]
+		s = s[n+1:]
 
-			for i := 0; i < 6-len(secondParts[1]); i++ {
-				uSeconds *= 10
+		if designator == 'S' {
+			if !inTime {
+				return Interval{}, fmt.Errorf("bad interval format: %s", src)
 			}
+			seconds, err := parseIntervalSeconds(number)
+			if err != nil {
+				return Interval{}, err
+			}
+			microseconds += seconds
+			continue
 		}
 
-		microseconds = hours * microsecondsPerHour
-		microseconds += minutes * microsecondsPerMinute
-		microseconds += seconds * microsecondsPerSecond
-		microseconds += uSeconds
+		scalar, err := strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			return Interval{}, fmt.Errorf("bad interval format: %s", src)
+		}
 
-		if negative {
-			microseconds = -microseconds
+		switch {
+		case designator == 'Y' && !inTime:
+			err = addIntervalField(&months, scalar, 12)
+		case designator == 'M' && !inTime:
+			err = addIntervalField(&months, scalar, 1)
+		case designator == 'W' && !inTime:
+			err = addIntervalField(&days, scalar, 7)
+		case designator == 'D' && !inTime:
+			err = addIntervalField(&days, scalar, 1)
+		case designator == 'H' && inTime:
+			microseconds += scalar * microsecondsPerHour
+		case designator == 'M' && inTime:
+			microseconds += scalar * microsecondsPerMinute
+		default:
+			return Interval{}, fmt.Errorf("bad interval format: %s", src)
+		}
+		if err != nil {
+			return Interval{}, err
 		}
 	}
 
-	*dst = Interval{Months: months, Days: days, Microseconds: microseconds, Status: Present}
-	return nil
+	if negateAll {
+		if days == math.MinInt32 || months == math.MinInt32 {
+			return Interval{}, errIntervalOutOfRange
+		}
+		microseconds, days, months = -microseconds, -days, -months
+	}
+
+	return Interval{Months: months, Days: days, Microseconds: microseconds, Status: Present}, nil
 }
 
 func (dst *Interval) DecodeBinary(ci *ConnInfo, src []byte) error {
@@ -218,6 +721,218 @@ func (src Interval) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
 	return append(buf, timeStr...), nil
 }
 
+// IntervalStyle is a PostgreSQL IntervalStyle output format.
+type IntervalStyle int8
+
+const (
+	// IntervalStylePostgres is the default PostgreSQL style. e.g. 1 year 2 mons 3 days 04:05:06
+	IntervalStylePostgres IntervalStyle = iota
+
+	// IntervalStylePostgresVerbose is the postgres_verbose style. e.g. @ 1 year 2 mons 3 days 4 hours 5 mins 6 secs
+	IntervalStylePostgresVerbose
+
+	// IntervalStyleSQLStandard is the sql_standard style. e.g. 1-2 or 3 4:05:06. Note that PostgreSQL only applies a
+	// leading minus sign to every field when the server IntervalStyle is also sql_standard.
+	IntervalStyleSQLStandard
+
+	// IntervalStyleISO8601 is the iso_8601 style. e.g. P1Y2M3DT4H5M6S
+	IntervalStyleISO8601
+)
+
+// EncodeTextStyle appends src formatted in style to buf. The output matches what PostgreSQL returns for the same
+// interval with IntervalStyle set to style. All styles are understood by DecodeText.
+func (src Interval) EncodeTextStyle(style IntervalStyle, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	year := int64(src.Months / 12)
+	mon := int64(src.Months % 12)
+	mday := int64(src.Days)
+	hour := src.Microseconds / microsecondsPerHour
+	min := (src.Microseconds % microsecondsPerHour) / microsecondsPerMinute
+	usec := src.Microseconds % microsecondsPerMinute
+
+	switch style {
+	case IntervalStylePostgres:
+		return src.EncodeText(nil, buf)
+	case IntervalStylePostgresVerbose:
+		return appendIntervalPostgresVerbose(buf, year, mon, mday, hour, min, usec), nil
+	case IntervalStyleSQLStandard:
+		return appendIntervalSQLStandard(buf, year, mon, mday, hour, min, usec), nil
+	case IntervalStyleISO8601:
+		return appendIntervalISO8601(buf, year, mon, mday, hour, min, usec), nil
+	}
+
+	return nil, fmt.Errorf("unknown interval style: %d", style)
+}
+
+func appendIntervalPostgresVerbose(buf []byte, year, mon, mday, hour, min, usec int64) []byte {
+	buf = append(buf, '@')
+
+	// The sign of the first non-zero field is factored out as "ago" and the signs of the remaining fields are relative
+	// to it.
+	isZero := true
+	isBefore := false
+	appendPart := func(value int64, unit string) {
+		if value == 0 {
+			return
+		}
+		if isZero {
+			isBefore = value < 0
+			isZero = false
+		}
+		if isBefore {
+			value = -value
+		}
+		buf = append(buf, ' ')
+		buf = strconv.AppendInt(buf, value, 10)
+		buf = append(buf, ' ')
+		buf = append(buf, unit...)
+		if value != 1 {
+			buf = append(buf, 's')
+		}
+	}
+
+	appendPart(year, "year")
+	appendPart(mon, "mon")
+	appendPart(mday, "day")
+	appendPart(hour, "hour")
+	appendPart(min, "min")
+
+	if usec != 0 {
+		if isZero {
+			isBefore = usec < 0
+			isZero = false
+		}
+		if isBefore {
+			usec = -usec
+		}
+		buf = append(buf, ' ')
+		if usec < 0 {
+			buf = append(buf, '-')
+		}
+		buf = appendIntervalSeconds(buf, abs64(usec), false)
+		if abs64(usec) == microsecondsPerSecond {
+			buf = append(buf, " sec"...)
+		} else {
+			buf = append(buf, " secs"...)
+		}
+	}
+
+	if isZero {
+		buf = append(buf, " 0"...)
+	}
+	if isBefore {
+		buf = append(buf, " ago"...)
+	}
+
+	return buf
+}
+
+func appendIntervalSQLStandard(buf []byte, year, mon, mday, hour, min, usec int64) []byte {
+	hasNegative := year < 0 || mon < 0 || mday < 0 || hour < 0 || min < 0 || usec < 0
+	hasPositive := year > 0 || mon > 0 || mday > 0 || hour > 0 || min > 0 || usec > 0
+	hasYearMonth := year != 0 || mon != 0
+	hasDayTime := mday != 0 || hour != 0 || min != 0 || usec != 0
+	sqlStandardValue := !(hasNegative && hasPositive) && !(hasYearMonth && hasDayTime)
+
+	if hasNegative && sqlStandardValue {
+		buf = append(buf, '-')
+		year, mon, mday, hour, min, usec = -year, -mon, -mday, -hour, -min, -usec
+	}
+
+	appendTime := func(buf []byte) []byte {
+		buf = strconv.AppendInt(buf, abs64(hour), 10)
+		buf = append(buf, fmt.Sprintf(":%02d:", abs64(min))...)
+		return appendIntervalSeconds(buf, abs64(usec), true)
+	}
+
+	switch {
+	case !hasNegative && !hasPositive:
+		return append(buf, '0')
+	case !sqlStandardValue:
+		// Mixed sign values are not representable in the SQL standard so every field gets an explicit sign.
+		sign := func(negative bool) byte {
+			if negative {
+				return '-'
+			}
+			return '+'
+		}
+		buf = append(buf, sign(year < 0 || mon < 0))
+		buf = append(buf, fmt.Sprintf("%d-%d ", abs64(year), abs64(mon))...)
+		buf = append(buf, sign(mday < 0))
+		buf = append(buf, fmt.Sprintf("%d ", abs64(mday))...)
+		buf = append(buf, sign(hour < 0 || min < 0 || usec < 0))
+		return appendTime(buf)
+	case hasYearMonth:
+		return append(buf, fmt.Sprintf("%d-%d", year, mon)...)
+	case mday != 0:
+		buf = append(buf, fmt.Sprintf("%d ", mday)...)
+		return appendTime(buf)
+	default:
+		return appendTime(buf)
+	}
+}
+
+func appendIntervalISO8601(buf []byte, year, mon, mday, hour, min, usec int64) []byte {
+	if year == 0 && mon == 0 && mday == 0 && hour == 0 && min == 0 && usec == 0 {
+		return append(buf, "PT0S"...)
+	}
+
+	appendPart := func(value int64, designator byte) {
+		if value != 0 {
+			buf = strconv.AppendInt(buf, value, 10)
+			buf = append(buf, designator)
+		}
+	}
+
+	buf = append(buf, 'P')
+	appendPart(year, 'Y')
+	appendPart(mon, 'M')
+	appendPart(mday, 'D')
+	if hour != 0 || min != 0 || usec != 0 {
+		buf = append(buf, 'T')
+	}
+	appendPart(hour, 'H')
+	appendPart(min, 'M')
+	if usec != 0 {
+		if usec < 0 {
+			buf = append(buf, '-')
+		}
+		buf = appendIntervalSeconds(buf, abs64(usec), false)
+		buf = append(buf, 'S')
+	}
+
+	return buf
+}
+
+// appendIntervalSeconds appends usec as seconds with trailing zeros of the fraction removed. If fillZeros is true the
+// whole seconds are zero padded to two digits.
+func appendIntervalSeconds(buf []byte, usec int64, fillZeros bool) []byte {
+	seconds := usec / microsecondsPerSecond
+	if fillZeros && seconds < 10 {
+		buf = append(buf, '0')
+	}
+	buf = strconv.AppendInt(buf, seconds, 10)
+
+	if fraction := usec % microsecondsPerSecond; fraction != 0 {
+		buf = append(buf, strings.TrimRight(fmt.Sprintf(".%06d", fraction), "0")...)
+	}
+
+	return buf
+}
+
+func abs64(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
 // EncodeBinary encodes src into w.
 func (src Interval) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
 	switch src.Status {
@@ -1,11 +1,14 @@
 package pgtype_test
 
 import (
+	"context"
+	"math"
 	"testing"
 	"time"
 
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgtype/testutil"
+	"github.com/jackc/pgx/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -72,3 +75,360 @@ func TestIntervalLossyConversionToDuration(t *testing.T) {
 	require.NoError(t, err)
 	assert.EqualValues(t, int64(2678400000000000), d.Nanoseconds())
 }
+
+func TestIntervalDuration(t *testing.T) {
+	tests := []struct {
+		interval pgtype.Interval
+		policy   pgtype.IntervalDurationPolicy
+		expected time.Duration
+		err      bool
+	}{
+		{
+			interval: pgtype.Interval{Microseconds: 90 * 60 * 1000000, Status: pgtype.Present},
+			policy:   pgtype.IntervalDurationStrict,
+			expected: 90 * time.Minute,
+		},
+		{
+			interval: pgtype.Interval{Days: 1, Status: pgtype.Present},
+			policy:   pgtype.IntervalDurationStrict,
+			err:      true,
+		},
+		{
+			interval: pgtype.Interval{Months: -1, Status: pgtype.Present},
+			policy:   pgtype.IntervalDurationStrict,
+			err:      true,
+		},
+		{
+			interval: pgtype.Interval{Months: 1, Days: 1, Microseconds: -1, Status: pgtype.Present},
+			policy:   pgtype.IntervalDurationJustify,
+			expected: 31*24*time.Hour - time.Microsecond,
+		},
+		{
+			interval: pgtype.Interval{Months: 12 * 292, Status: pgtype.Present},
+			policy:   pgtype.IntervalDurationJustify,
+			expected: 12 * 292 * 30 * 24 * time.Hour,
+		},
+		{
+			interval: pgtype.Interval{Months: 12 * 300, Status: pgtype.Present},
+			policy:   pgtype.IntervalDurationJustify,
+			err:      true,
+		},
+		{
+			interval: pgtype.Interval{Months: math.MaxInt32, Days: math.MaxInt32, Status: pgtype.Present},
+			policy:   pgtype.IntervalDurationJustify,
+			err:      true,
+		},
+		{
+			interval: pgtype.Interval{Microseconds: math.MinInt64, Status: pgtype.Present},
+			policy:   pgtype.IntervalDurationStrict,
+			err:      true,
+		},
+		{
+			interval: pgtype.Interval{Status: pgtype.Null},
+			policy:   pgtype.IntervalDurationJustify,
+			err:      true,
+		},
+	}
+
+	for i, tt := range tests {
+		d, err := tt.interval.Duration(tt.policy)
+		if tt.err {
+			assert.Errorf(t, err, "%d", i)
+			continue
+		}
+		if assert.NoErrorf(t, err, "%d", i) {
+			assert.Equalf(t, tt.expected, d, "%d", i)
+		}
+	}
+
+	interval := pgtype.Interval{Months: 12 * 300, Status: pgtype.Present}
+	var d time.Duration
+	err := interval.AssignTo(&d)
+	require.Error(t, err)
+}
+
+func TestIntervalDurationFrom(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		interval pgtype.Interval
+		from     time.Time
+		expected time.Duration
+		err      bool
+	}{
+		{
+			interval: pgtype.Interval{Months: 1, Status: pgtype.Present},
+			from:     time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
+			expected: 28 * 24 * time.Hour,
+		},
+		{
+			interval: pgtype.Interval{Months: 1, Status: pgtype.Present},
+			from:     time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+			expected: 29 * 24 * time.Hour,
+		},
+		{
+			interval: pgtype.Interval{Days: 1, Microseconds: 1000000, Status: pgtype.Present},
+			from:     time.Date(2021, 3, 13, 12, 0, 0, 0, newYork),
+			expected: 23*time.Hour + time.Second,
+		},
+		{
+			interval: pgtype.Interval{Days: -1, Status: pgtype.Present},
+			from:     time.Date(2021, 11, 8, 0, 0, 0, 0, newYork),
+			expected: -25 * time.Hour,
+		},
+		{
+			interval: pgtype.Interval{Months: 12 * 300, Status: pgtype.Present},
+			from:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			err:      true,
+		},
+		{
+			interval: pgtype.Interval{Status: pgtype.Null},
+			from:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			err:      true,
+		},
+	}
+
+	for i, tt := range tests {
+		d, err := tt.interval.DurationFrom(tt.from)
+		if tt.err {
+			assert.Errorf(t, err, "%d", i)
+			continue
+		}
+		if assert.NoErrorf(t, err, "%d", i) {
+			assert.Equalf(t, tt.expected, d, "%d", i)
+		}
+	}
+}
+
+func TestIntervalAddTo(t *testing.T) {
+	from := time.Date(2021, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	interval := pgtype.Interval{Months: 1, Days: 1, Microseconds: 1, Status: pgtype.Present}
+	assert.Equal(t, time.Date(2021, 3, 1, 0, 0, 0, 1000, time.UTC), interval.AddTo(from))
+
+	interval = pgtype.Interval{Months: 1, Status: pgtype.Present}
+	assert.Equal(t, time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC), interval.AddTo(time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2024, 2, 29, 12, 30, 0, 0, time.UTC), interval.AddTo(time.Date(2024, 1, 31, 12, 30, 0, 0, time.UTC)))
+
+	interval = pgtype.Interval{Months: -13, Status: pgtype.Present}
+	assert.Equal(t, time.Date(2022, 2, 28, 0, 0, 0, 0, time.UTC), interval.AddTo(time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC)))
+
+	interval = pgtype.Interval{Microseconds: math.MaxInt64, Status: pgtype.Present}
+	assert.Equal(t, 292277, interval.AddTo(from).Year()-from.Year())
+
+	interval = pgtype.Interval{Status: pgtype.Null}
+	assert.Equal(t, from, interval.AddTo(from))
+}
+
+func TestIntervalStyles(t *testing.T) {
+	hms := int64(4*time.Hour+5*time.Minute+6*time.Second) / 1000
+
+	tests := []struct {
+		interval        pgtype.Interval
+		postgres        string
+		postgresVerbose string
+		sqlStandard     string
+		iso8601         string
+	}{
+		{
+			interval:        pgtype.Interval{Months: 14, Status: pgtype.Present},
+			postgres:        "1 year 2 mons",
+			postgresVerbose: "@ 1 year 2 mons",
+			sqlStandard:     "1-2",
+			iso8601:         "P1Y2M",
+		},
+		{
+			interval:        pgtype.Interval{Days: 3, Microseconds: hms, Status: pgtype.Present},
+			postgres:        "3 days 04:05:06",
+			postgresVerbose: "@ 3 days 4 hours 5 mins 6 secs",
+			sqlStandard:     "3 4:05:06",
+			iso8601:         "P3DT4H5M6S",
+		},
+		{
+			interval:        pgtype.Interval{Months: -14, Days: 3, Microseconds: -hms, Status: pgtype.Present},
+			postgres:        "-1 years -2 mons +3 days -04:05:06",
+			postgresVerbose: "@ 1 year 2 mons -3 days 4 hours 5 mins 6 secs ago",
+			sqlStandard:     "-1-2 +3 -4:05:06",
+			iso8601:         "P-1Y-2M3DT-4H-5M-6S",
+		},
+		{
+			interval:        pgtype.Interval{Days: -3, Microseconds: -hms, Status: pgtype.Present},
+			postgres:        "-3 days -04:05:06",
+			postgresVerbose: "@ 3 days 4 hours 5 mins 6 secs ago",
+			sqlStandard:     "-3 4:05:06",
+			iso8601:         "P-3DT-4H-5M-6S",
+		},
+		{
+			interval:        pgtype.Interval{Months: 1, Days: 2, Status: pgtype.Present},
+			postgres:        "1 mon 2 days",
+			postgresVerbose: "@ 1 mon 2 days",
+			sqlStandard:     "+0-1 +2 +0:00:00",
+			iso8601:         "P1M2D",
+		},
+		{
+			interval:        pgtype.Interval{Microseconds: 1500000, Status: pgtype.Present},
+			postgres:        "00:00:01.5",
+			postgresVerbose: "@ 1.5 secs",
+			sqlStandard:     "0:00:01.5",
+			iso8601:         "PT1.5S",
+		},
+		{
+			interval:        pgtype.Interval{Microseconds: -1000000, Status: pgtype.Present},
+			postgres:        "-00:00:01",
+			postgresVerbose: "@ 1 sec ago",
+			sqlStandard:     "-0:00:01",
+			iso8601:         "PT-1S",
+		},
+		{
+			interval:        pgtype.Interval{Status: pgtype.Present},
+			postgres:        "00:00:00",
+			postgresVerbose: "@ 0",
+			sqlStandard:     "0",
+			iso8601:         "PT0S",
+		},
+	}
+
+	for i, tt := range tests {
+		for _, text := range []string{tt.postgres, tt.postgresVerbose, tt.sqlStandard, tt.iso8601} {
+			var interval pgtype.Interval
+			err := interval.DecodeText(nil, []byte(text))
+			if assert.NoErrorf(t, err, "%d: %s", i, text) {
+				assert.Equalf(t, tt.interval, interval, "%d: %s", i, text)
+			}
+		}
+
+		for style, expected := range map[pgtype.IntervalStyle]string{
+			pgtype.IntervalStylePostgresVerbose: tt.postgresVerbose,
+			pgtype.IntervalStyleSQLStandard:     tt.sqlStandard,
+			pgtype.IntervalStyleISO8601:         tt.iso8601,
+		} {
+			buf, err := tt.interval.EncodeTextStyle(style, nil)
+			if assert.NoErrorf(t, err, "%d: %d", i, style) {
+				assert.Equalf(t, expected, string(buf), "%d: %d", i, style)
+			}
+		}
+
+		buf, err := tt.interval.EncodeTextStyle(pgtype.IntervalStylePostgres, nil)
+		require.NoErrorf(t, err, "%d", i)
+		var interval pgtype.Interval
+		err = interval.DecodeText(nil, buf)
+		require.NoErrorf(t, err, "%d", i)
+		assert.Equalf(t, tt.interval, interval, "%d", i)
+	}
+}
+
+func TestIntervalDecodeTextISO8601Input(t *testing.T) {
+	tests := []struct {
+		text     string
+		interval pgtype.Interval
+	}{
+		{text: "P2W", interval: pgtype.Interval{Days: 14, Status: pgtype.Present}},
+		{text: "-P1DT1H", interval: pgtype.Interval{Days: -1, Microseconds: -3600000000, Status: pgtype.Present}},
+		{text: "PT0.000001S", interval: pgtype.Interval{Microseconds: 1, Status: pgtype.Present}},
+	}
+
+	for i, tt := range tests {
+		var interval pgtype.Interval
+		err := interval.DecodeText(nil, []byte(tt.text))
+		if assert.NoErrorf(t, err, "%d", i) {
+			assert.Equalf(t, tt.interval, interval, "%d", i)
+		}
+	}
+
+	for i, text := range []string{"P", "P1H", "PT1D", "P1Y2", "PT1S2M3", "@ 1 fortnight", "1-x"} {
+		var interval pgtype.Interval
+		err := interval.DecodeText(nil, []byte(text))
+		assert.Errorf(t, err, "%d: %s", i, text)
+	}
+}
+
+func TestIntervalDecodeTextOutOfRange(t *testing.T) {
+	for i, text := range []string{
+		"178956971 years",
+		"2147483647 mons 1 mon",
+		"4294967297 days",
+		"@ 2147483648 days",
+		"@ 2147483647 mons 1 mon ago",
+		"178956970-8",
+		"2147483648 0:00:00",
+		"P178956971Y",
+		"P306783379W",
+		"-P2147483648M",
+	} {
+		var interval pgtype.Interval
+		err := interval.DecodeText(nil, []byte(text))
+		if assert.Errorf(t, err, "%d: %s", i, text) {
+			assert.Containsf(t, err.Error(), "interval out of range", "%d: %s", i, text)
+		}
+	}
+
+	for i, text := range []string{"2147483647 mons -2147483648 days", "@ 178956970 years 7 mons ago", "-P2147483647D"} {
+		var interval pgtype.Interval
+		err := interval.DecodeText(nil, []byte(text))
+		assert.NoErrorf(t, err, "%d: %s", i, text)
+	}
+}
+
+func TestIntervalDecodeTextServerStyles(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	expected := pgtype.Interval{Months: -14, Days: 3, Microseconds: -14706789000, Status: pgtype.Present}
+
+	for _, style := range []string{"postgres", "postgres_verbose", "sql_standard", "iso_8601"} {
+		_, err := conn.Exec(context.Background(), "set intervalstyle = "+style)
+		require.NoError(t, err)
+
+		var interval pgtype.Interval
+		err = conn.QueryRow(
+			context.Background(),
+			"select '-1 year -2 mons +3 days -04:05:06.789'::interval",
+			pgx.QueryResultFormats{pgx.TextFormatCode},
+		).Scan(&interval)
+		require.NoErrorf(t, err, "%s", style)
+		assert.Equalf(t, expected, interval, "%s", style)
+	}
+}
+
+func TestIntervalApplyTypeModifier(t *testing.T) {
+	const (
+		month  = 1 << 1
+		year   = 1 << 2
+		day    = 1 << 3
+		hour   = 1 << 10
+		minute = 1 << 11
+		second = 1 << 12
+	)
+	intervalTypmod := func(fields, precision int32) int32 { return fields<<16 | precision }
+	src := pgtype.Interval{Months: 27, Days: 3, Microseconds: 3*3600000000 + 25*60000000 + 45500000, Status: pgtype.Present}
+
+	tests := []struct {
+		typmod int32
+		result pgtype.Interval
+	}{
+		{typmod: -1, result: src},
+		{typmod: intervalTypmod(year, 0xffff), result: pgtype.Interval{Months: 24, Status: pgtype.Present}},
+		{typmod: intervalTypmod(year|month, 0xffff), result: pgtype.Interval{Months: 27, Status: pgtype.Present}},
+		{typmod: intervalTypmod(day, 0xffff), result: pgtype.Interval{Months: 27, Days: 3, Status: pgtype.Present}},
+		{typmod: intervalTypmod(day|hour, 0xffff), result: pgtype.Interval{Months: 27, Days: 3, Microseconds: 3 * 3600000000, Status: pgtype.Present}},
+		{typmod: intervalTypmod(hour|minute, 0xffff), result: pgtype.Interval{Months: 27, Days: 3, Microseconds: 3*3600000000 + 25*60000000, Status: pgtype.Present}},
+		{typmod: intervalTypmod(second, 0), result: pgtype.Interval{Months: 27, Days: 3, Microseconds: 3*3600000000 + 25*60000000 + 46000000, Status: pgtype.Present}},
+		{typmod: intervalTypmod(0x7fff, 0), result: pgtype.Interval{Months: 27, Days: 3, Microseconds: 3*3600000000 + 25*60000000 + 46000000, Status: pgtype.Present}},
+		{typmod: intervalTypmod(day|hour|minute|second, 1), result: pgtype.Interval{Months: 27, Days: 3, Microseconds: 3*3600000000 + 25*60000000 + 45500000, Status: pgtype.Present}},
+	}
+
+	for i, tt := range tests {
+		v := src
+		err := v.ApplyTypeModifier(tt.typmod)
+		require.NoErrorf(t, err, "%d", i)
+		assert.Equalf(t, tt.result, v, "%d", i)
+	}
+
+	v := pgtype.Interval{Microseconds: -1500000, Status: pgtype.Present}
+	require.NoError(t, v.ApplyTypeModifier(intervalTypmod(second, 0)))
+	require.Equal(t, int64(-2000000), v.Microseconds)
+
+	require.Error(t, src.ApplyTypeModifier(intervalTypmod(year|day, 0xffff)))
+	require.Error(t, src.ApplyTypeModifier(intervalTypmod(second, 7)))
+}
@@ -16,7 +16,20 @@ type Lseg struct {
 	Status Status
 }
 
+// Set converts src to dst. src may be nil or a string in WKT or the PostgreSQL text format.
 func (dst *Lseg) Set(src interface{}) error {
+	if src == nil {
+		*dst = Lseg{Status: Null}
+		return nil
+	}
+
+	if value, ok := src.(string); ok {
+		if isWKT(value) {
+			return dst.UnmarshalWKT([]byte(value))
+		}
+		return dst.DecodeText(nil, []byte(value))
+	}
+
 	return fmt.Errorf("cannot convert %v to Lseg", src)
 }
 
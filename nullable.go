@@ -0,0 +1,343 @@
+package pgtype
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+)
+
+// Nullable represents a value of any Go type T that may be NULL. It is converted with the data type registered in
+// ConnInfo for T (or for the underlying type of T). e.g. Nullable[time.Time] uses timestamptz, Nullable[[]int32] uses
+// _int4, and Nullable[MyEnum] where MyEnum is defined as type MyEnum string uses text.
+//
+// It is not named Null because Null is already the Status constant, and the value is named V rather than Value
+// because Value is the database/sql/driver Valuer method.
+//
+// When scanned with ConnInfo.Scan, as pgx does, the source is decoded with the data type registered for its OID and the
+// result is assigned to T. e.g. a date or timestamp column can be scanned into Nullable[time.Time]. It is an error if
+// the decoded value cannot be assigned to T.
+//
+// DecodeText, DecodeBinary, EncodeText and EncodeBinary use the data type registered for T in the ConnInfo they are
+// passed. As the source OID is not known, DecodeText and DecodeBinary require the data type for T to be the type of
+// the source. Set, AssignTo, Scan and Value have no ConnInfo. They use values of type T directly and otherwise only use
+// the data types built in to pgtype.
+type Nullable[T any] struct {
+	V     T
+	Valid bool
+}
+
+func (src Nullable[T]) valueType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// newValue returns a new instance of the data type registered for T in ci. If ci is nil the built-in data types are
+// used.
+func (src Nullable[T]) newValue(ci *ConnInfo) (Value, *DataType, error) {
+	dt, ok := dataTypeForGoType(connInfoOrDefault(ci), src.valueType())
+	if !ok {
+		return nil, nil, fmt.Errorf("unable to find data type for %v", src.valueType())
+	}
+	return NewValue(dt.Value), dt, nil
+}
+
+func (dst *Nullable[T]) Set(src interface{}) error {
+	// untyped nil and typed nil interfaces are different
+	if src == nil {
+		*dst = Nullable[T]{}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case Nullable[T]:
+		*dst = value
+		return nil
+	case *Nullable[T]:
+		if value == nil {
+			*dst = Nullable[T]{}
+		} else {
+			*dst = *value
+		}
+		return nil
+	case T:
+		*dst = Nullable[T]{V: value, Valid: true}
+		return nil
+	case *T:
+		if value == nil {
+			*dst = Nullable[T]{}
+		} else {
+			*dst = Nullable[T]{V: *value, Valid: true}
+		}
+		return nil
+	}
+
+	value, _, err := dst.newValue(nil)
+	if err != nil {
+		return err
+	}
+
+	err = value.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if value.Get() == nil {
+		*dst = Nullable[T]{}
+		return nil
+	}
+
+	var v T
+	err = assignDecodedValue(value, &v)
+	if err != nil {
+		return err
+	}
+
+	*dst = Nullable[T]{V: v, Valid: true}
+	return nil
+}
+
+func (dst Nullable[T]) Get() interface{} {
+	if !dst.Valid {
+		return nil
+	}
+	return dst.V
+}
+
+func (src *Nullable[T]) AssignTo(dst interface{}) error {
+	switch v := dst.(type) {
+	case *Nullable[T]:
+		*v = *src
+		return nil
+	case *T:
+		if !src.Valid {
+			return NullAssignTo(dst)
+		}
+		*v = src.V
+		return nil
+	case **T:
+		if !src.Valid {
+			*v = nil
+		} else {
+			value := src.V
+			*v = &value
+		}
+		return nil
+	}
+
+	if !src.Valid {
+		return NullAssignTo(dst)
+	}
+
+	value, _, err := src.newValue(nil)
+	if err != nil {
+		return err
+	}
+
+	err = value.Set(src.V)
+	if err != nil {
+		return err
+	}
+
+	return value.AssignTo(dst)
+}
+
+func (dst *Nullable[T]) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Nullable[T]{}
+		return nil
+	}
+
+	ci = connInfoOrDefault(ci)
+
+	_, dt, err := dst.newValue(ci)
+	if err != nil {
+		return err
+	}
+
+	return dst.decode(ci, dt, TextFormatCode, src)
+}
+
+func (dst *Nullable[T]) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Nullable[T]{}
+		return nil
+	}
+
+	ci = connInfoOrDefault(ci)
+
+	_, dt, err := dst.newValue(ci)
+	if err != nil {
+		return err
+	}
+
+	return dst.decode(ci, dt, BinaryFormatCode, src)
+}
+
+// decodeOID decodes src with the data type registered for oid. It is used by ConnInfo.Scan so a column of a different
+// type than the one registered for T, such as date into Nullable[time.Time], is decoded correctly.
+func (dst *Nullable[T]) decodeOID(ci *ConnInfo, oid uint32, formatCode int16, src []byte) error {
+	if src == nil {
+		*dst = Nullable[T]{}
+		return nil
+	}
+
+	dt, ok := ci.DataTypeForOID(oid)
+	if !ok {
+		var err error
+		_, dt, err = dst.newValue(ci)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dst.decode(ci, dt, formatCode, src)
+}
+
+func (dst *Nullable[T]) decode(ci *ConnInfo, dt *DataType, formatCode int16, src []byte) error {
+	value := NewValue(dt.Value)
+
+	switch formatCode {
+	case BinaryFormatCode:
+		decoder, ok := value.(BinaryDecoder)
+		if !ok {
+			return fmt.Errorf("%s does not support the binary format", dt.Name)
+		}
+		err := decoder.DecodeBinary(ci, src)
+		if err != nil {
+			return err
+		}
+	case TextFormatCode:
+		decoder, ok := value.(TextDecoder)
+		if !ok {
+			return fmt.Errorf("%s does not support the text format", dt.Name)
+		}
+		err := decoder.DecodeText(ci, src)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown format code %d", formatCode)
+	}
+
+	var v T
+	err := assignDecodedValue(value, &v)
+	if err != nil {
+		return fmt.Errorf("cannot decode %s into %v: %w", dt.Name, dst.valueType(), err)
+	}
+
+	*dst = Nullable[T]{V: v, Valid: true}
+	return nil
+}
+
+func (src Nullable[T]) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	if !src.Valid {
+		return nil, nil
+	}
+
+	ci = connInfoOrDefault(ci)
+
+	value, dt, err := src.newValue(ci)
+	if err != nil {
+		return nil, err
+	}
+
+	encoder, ok := value.(TextEncoder)
+	if !ok {
+		return nil, fmt.Errorf("%s does not support the text format", dt.Name)
+	}
+
+	err = value.Set(src.V)
+	if err != nil {
+		return nil, err
+	}
+
+	return encoder.EncodeText(ci, buf)
+}
+
+func (src Nullable[T]) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	if !src.Valid {
+		return nil, nil
+	}
+
+	ci = connInfoOrDefault(ci)
+
+	value, dt, err := src.newValue(ci)
+	if err != nil {
+		return nil, err
+	}
+
+	encoder, ok := value.(BinaryEncoder)
+	if !ok {
+		return nil, fmt.Errorf("%s does not support the binary format", dt.Name)
+	}
+
+	err = value.Set(src.V)
+	if err != nil {
+		return nil, err
+	}
+
+	return encoder.EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Nullable[T]) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Nullable[T]{}
+		return nil
+	}
+
+	// A []byte src may be reused by database/sql so it must be copied by the data type's Scan.
+	if _, isBytes := src.([]byte); !isBytes {
+		if value, ok := src.(T); ok {
+			*dst = Nullable[T]{V: value, Valid: true}
+			return nil
+		}
+	}
+
+	value, dt, err := dst.newValue(nil)
+	if err != nil {
+		return err
+	}
+
+	scanner, ok := value.(sql.Scanner)
+	if !ok {
+		return fmt.Errorf("%s does not support database/sql Scan", dt.Name)
+	}
+
+	err = scanner.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	var v T
+	err = assignDecodedValue(value, &v)
+	if err != nil {
+		return err
+	}
+
+	*dst = Nullable[T]{V: v, Valid: true}
+	return nil
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Nullable[T]) Value() (driver.Value, error) {
+	if !src.Valid {
+		return nil, nil
+	}
+
+	if driver.IsValue(src.V) {
+		return src.V, nil
+	}
+
+	value, _, err := src.newValue(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = value.Set(src.V)
+	if err != nil {
+		return nil, err
+	}
+
+	return DatabaseSQLValue(connInfoOrDefault(nil), value)
+}
@@ -0,0 +1,175 @@
+package pgtype_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type nullableTestEnum string
+
+func TestNullableTranscode(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	var ts pgtype.Nullable[time.Time]
+	err := conn.QueryRow(context.Background(), "select $1::timestamptz", pgtype.Nullable[time.Time]{V: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), Valid: true}).Scan(&ts)
+	require.NoError(t, err)
+	require.True(t, ts.Valid)
+	require.True(t, ts.V.Equal(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)))
+
+	var ints pgtype.Nullable[[]int32]
+	err = conn.QueryRow(context.Background(), "select null::int4[]").Scan(&ints)
+	require.NoError(t, err)
+	require.False(t, ints.Valid)
+
+	var enum pgtype.Nullable[nullableTestEnum]
+	err = conn.QueryRow(context.Background(), "select 'red'::text").Scan(&enum)
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Nullable[nullableTestEnum]{V: "red", Valid: true}, enum)
+}
+
+func TestNullableCodecs(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	ts := pgtype.Nullable[time.Time]{V: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), Valid: true}
+	buf, err := ts.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+
+	var tsDst pgtype.Nullable[time.Time]
+	err = tsDst.DecodeBinary(ci, buf)
+	require.NoError(t, err)
+	require.True(t, tsDst.Valid)
+	require.True(t, ts.V.Equal(tsDst.V))
+
+	ints := pgtype.Nullable[[]int32]{V: []int32{1, 2, 3}, Valid: true}
+	buf, err = ints.EncodeText(ci, nil)
+	require.NoError(t, err)
+	require.Equal(t, "{1,2,3}", string(buf))
+
+	var intsDst pgtype.Nullable[[]int32]
+	err = intsDst.DecodeText(ci, buf)
+	require.NoError(t, err)
+	require.Equal(t, ints, intsDst)
+
+	enum := pgtype.Nullable[nullableTestEnum]{V: "red", Valid: true}
+	buf, err = enum.EncodeText(ci, nil)
+	require.NoError(t, err)
+	require.Equal(t, "red", string(buf))
+
+	var enumDst pgtype.Nullable[nullableTestEnum]
+	err = enumDst.DecodeBinary(ci, buf)
+	require.NoError(t, err)
+	require.Equal(t, enum, enumDst)
+
+	err = enumDst.DecodeText(ci, nil)
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Nullable[nullableTestEnum]{}, enumDst)
+
+	buf, err = enumDst.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+	require.Nil(t, buf)
+}
+
+func TestNullableScanUsesSourceOID(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	date := pgtype.Date{Time: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), Status: pgtype.Present}
+	dateBuf, err := date.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+
+	timestamp := pgtype.Timestamp{Time: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), Status: pgtype.Present}
+	timestampBuf, err := timestamp.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		oid        uint32
+		formatCode int16
+		src        []byte
+		result     time.Time
+	}{
+		{oid: pgtype.DateOID, formatCode: pgtype.BinaryFormatCode, src: dateBuf, result: date.Time},
+		{oid: pgtype.DateOID, formatCode: pgtype.TextFormatCode, src: []byte("2020-01-02"), result: date.Time},
+		{oid: pgtype.TimestampOID, formatCode: pgtype.BinaryFormatCode, src: timestampBuf, result: timestamp.Time},
+		{oid: pgtype.TimestampOID, formatCode: pgtype.TextFormatCode, src: []byte("2020-01-02 03:04:05"), result: timestamp.Time},
+	}
+
+	for i, tt := range tests {
+		var n pgtype.Nullable[time.Time]
+		err := ci.Scan(tt.oid, tt.formatCode, tt.src, &n)
+		require.NoErrorf(t, err, "%d", i)
+		require.Truef(t, n.Valid, "%d", i)
+		require.Truef(t, n.V.Equal(tt.result), "%d: %v", i, n.V)
+
+		err = ci.Scan(tt.oid, tt.formatCode, nil, &n)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, pgtype.Nullable[time.Time]{}, n, "%d", i)
+	}
+
+	var n pgtype.Nullable[time.Time]
+	err = ci.Scan(pgtype.BoolOID, pgtype.BinaryFormatCode, []byte{1}, &n)
+	require.Error(t, err)
+}
+
+func TestNullableSetAndAssignTo(t *testing.T) {
+	var n pgtype.Nullable[int64]
+
+	err := n.Set(int64(42))
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Nullable[int64]{V: 42, Valid: true}, n)
+
+	err = n.Set("7")
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Nullable[int64]{V: 7, Valid: true}, n)
+
+	var i32 int32
+	err = n.AssignTo(&i32)
+	require.NoError(t, err)
+	require.EqualValues(t, 7, i32)
+
+	var pi *int64
+	err = n.AssignTo(&pi)
+	require.NoError(t, err)
+	require.EqualValues(t, 7, *pi)
+
+	err = n.Set((*int64)(nil))
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Nullable[int64]{}, n)
+	require.Nil(t, n.Get())
+
+	err = n.AssignTo(&pi)
+	require.NoError(t, err)
+	require.Nil(t, pi)
+
+	err = n.AssignTo(&i32)
+	require.Error(t, err)
+}
+
+func TestNullableScanAndValue(t *testing.T) {
+	var n pgtype.Nullable[time.Time]
+
+	err := n.Scan("2020-01-02 03:04:05Z")
+	require.NoError(t, err)
+	require.True(t, n.Valid)
+	require.True(t, n.V.Equal(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)))
+
+	value, err := n.Value()
+	require.NoError(t, err)
+	require.Equal(t, n.V, value)
+
+	err = n.Scan(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Nullable[time.Time]{V: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC), Valid: true}, n)
+
+	err = n.Scan(nil)
+	require.NoError(t, err)
+	require.False(t, n.Valid)
+
+	value, err = n.Value()
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
@@ -237,3 +237,193 @@ func (dst *Nummultirange) Scan(src interface{}) error {
 func (src Nummultirange) Value() (driver.Value, error) {
 	return EncodeValueText(src)
 }
+
+// rangeValues returns the normalized range values of src. It is an error if a range is not Present or is not a valid
+// range.
+func (src Nummultirange) rangeValues() ([]rangeValue[Numeric], error) {
+	ranges := make([]rangeValue[Numeric], len(src.Ranges))
+	for i, r := range src.Ranges {
+		if r.Status != Present {
+			return nil, fmt.Errorf("range %d is not present", i)
+		}
+
+		var err error
+		ranges[i], err = r.rangeValue()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return normalizeRangeValues(ranges, numericRangeElementOps), nil
+}
+
+// rangeValuePair returns the normalized range values of src and x.
+func (src Nummultirange) rangeValuePair(x Nummultirange) ([]rangeValue[Numeric], []rangeValue[Numeric], error) {
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return nil, nil, err
+	}
+	xRanges, err := x.rangeValues()
+	if err != nil {
+		return nil, nil, err
+	}
+	return ranges, xRanges, nil
+}
+
+func newNummultirangeFromRangeValues(ranges []rangeValue[Numeric]) Nummultirange {
+	dst := Nummultirange{Status: Present}
+	if len(ranges) > 0 {
+		dst.Ranges = make([]Numrange, len(ranges))
+		for i := range ranges {
+			dst.Ranges[i] = newNumrangeFromRangeValue(ranges[i])
+		}
+	}
+	return dst
+}
+
+// Normalize returns src in the form PostgreSQL uses. Empty ranges are removed, overlapping and adjacent ranges are
+// merged, and the ranges are canonicalized and sorted. It is an error if a range is not Present or is not a valid
+// range. The other operations on Nummultirange have the same requirements.
+func (src Nummultirange) Normalize() (Nummultirange, error) {
+	if src.Status != Present {
+		return Nummultirange{Status: src.Status}, nil
+	}
+
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return Nummultirange{}, err
+	}
+	return newNummultirangeFromRangeValues(ranges), nil
+}
+
+// IsEmpty reports whether src contains no values.
+func (src Nummultirange) IsEmpty() (bool, error) {
+	if src.Status != Present {
+		return false, nil
+	}
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return false, err
+	}
+	return len(ranges) == 0, nil
+}
+
+// Contains reports whether src contains elem like the PostgreSQL @> operator.
+func (src Nummultirange) Contains(elem Numeric) (bool, error) {
+	if src.Status != Present || elem.Status != Present {
+		return false, nil
+	}
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return false, err
+	}
+	for _, r := range ranges {
+		if r.containsElem(elem, numericRangeElementOps) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ContainsRange reports whether src contains r like the PostgreSQL @> operator.
+func (src Nummultirange) ContainsRange(r Numrange) (bool, error) {
+	if src.Status != Present || r.Status != Present {
+		return false, nil
+	}
+	return src.ContainsMultirange(Nummultirange{Ranges: []Numrange{r}, Status: Present})
+}
+
+// ContainsMultirange reports whether src contains x like the PostgreSQL @> operator.
+func (src Nummultirange) ContainsMultirange(x Nummultirange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+
+	for _, xr := range xRanges {
+		contained := false
+		for _, r := range ranges {
+			if r.containsRange(xr, numericRangeElementOps) {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Overlaps reports whether src and x have values in common like the PostgreSQL && operator.
+func (src Nummultirange) Overlaps(x Nummultirange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return len(intersectRangeValues(ranges, xRanges, numericRangeElementOps)) > 0, nil
+}
+
+// Union returns the normalized union of src and x like the PostgreSQL + operator. If either multirange is not Present
+// the result is Null.
+func (src Nummultirange) Union(x Nummultirange) (Nummultirange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Nummultirange{Status: Null}, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return Nummultirange{}, err
+	}
+	return newNummultirangeFromRangeValues(unionRangeValues(ranges, xRanges, numericRangeElementOps)), nil
+}
+
+// Intersect returns the normalized intersection of src and x like the PostgreSQL * operator. If either multirange is
+// not Present the result is Null.
+func (src Nummultirange) Intersect(x Nummultirange) (Nummultirange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Nummultirange{Status: Null}, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return Nummultirange{}, err
+	}
+	return newNummultirangeFromRangeValues(intersectRangeValues(ranges, xRanges, numericRangeElementOps)), nil
+}
+
+// Difference returns the normalized values of src that are not in x like the PostgreSQL - operator. If either
+// multirange is not Present the result is Null.
+func (src Nummultirange) Difference(x Nummultirange) (Nummultirange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Nummultirange{Status: Null}, nil
+	}
+	ranges, xRanges, err := src.rangeValuePair(x)
+	if err != nil {
+		return Nummultirange{}, err
+	}
+	return newNummultirangeFromRangeValues(differenceRangeValues(ranges, xRanges, numericRangeElementOps)), nil
+}
+
+// Gaps returns the ranges between the normalized ranges of src in order. The values before the first range and after
+// the last range are not included.
+func (src Nummultirange) Gaps() ([]Numrange, error) {
+	if src.Status != Present {
+		return nil, nil
+	}
+	ranges, err := src.rangeValues()
+	if err != nil {
+		return nil, err
+	}
+
+	gaps := gapRangeValues(ranges, numericRangeElementOps)
+	result := make([]Numrange, len(gaps))
+	for i := range gaps {
+		result[i] = newNumrangeFromRangeValue(gaps[i])
+	}
+	return result, nil
+}
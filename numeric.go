@@ -29,6 +29,7 @@ const (
 
 var big0 *big.Int = big.NewInt(0)
 var big1 *big.Int = big.NewInt(1)
+var big5 *big.Int = big.NewInt(5)
 var big10 *big.Int = big.NewInt(10)
 var big100 *big.Int = big.NewInt(100)
 var big1000 *big.Int = big.NewInt(1000)
@@ -213,6 +214,30 @@ func (dst *Numeric) Set(src interface{}) error {
 		} else {
 			return dst.Set(*value)
 		}
+	case big.Int:
+		*dst = Numeric{Int: new(big.Int).Set(&value), Status: Present}
+	case *big.Int:
+		if value == nil {
+			*dst = Numeric{Status: Null}
+		} else {
+			*dst = Numeric{Int: new(big.Int).Set(value), Status: Present}
+		}
+	case big.Rat:
+		return dst.SetBigRat(&value, numericBigRatMaxScale)
+	case *big.Rat:
+		if value == nil {
+			*dst = Numeric{Status: Null}
+		} else {
+			return dst.SetBigRat(value, numericBigRatMaxScale)
+		}
+	case big.Float:
+		return dst.setBigFloat(&value)
+	case *big.Float:
+		if value == nil {
+			*dst = Numeric{Status: Null}
+		} else {
+			return dst.setBigFloat(value)
+		}
 	case InfinityModifier:
 		*dst = Numeric{InfinityModifier: value, Status: Present}
 	default:
@@ -225,6 +250,98 @@ func (dst *Numeric) Set(src interface{}) error {
 	return nil
 }
 
+// numericBigRatMaxScale is the number of digits after the decimal point Set keeps of a *big.Rat that has no exact
+// decimal representation.
+const numericBigRatMaxScale = 20
+
+// SetBigRat sets dst to value. A value with a terminating decimal representation is set exactly. Other values such as
+// 1/3 are rounded half away from zero to maxScale digits after the decimal point. Set uses a maxScale of 20.
+func (dst *Numeric) SetBigRat(value *big.Rat, maxScale int32) error {
+	if value == nil {
+		*dst = Numeric{Status: Null}
+		return nil
+	}
+
+	num := new(big.Int).Set(value.Num())
+	den := value.Denom()
+
+	// The denominator of a reduced fraction with a terminating decimal representation only has the prime factors 2 and
+	// 5. The number of digits after the decimal point is the larger of their multiplicities.
+	var twos, fives int32
+	rest := new(big.Int).Set(den)
+	for rest.Bit(0) == 0 {
+		rest.Rsh(rest, 1)
+		twos++
+	}
+	for mod := new(big.Int); ; {
+		q, _ := new(big.Int).QuoRem(rest, big5, mod)
+		if mod.Sign() != 0 {
+			break
+		}
+		rest = q
+		fives++
+	}
+
+	scale := maxScale
+	if rest.Cmp(big1) == 0 {
+		scale = twos
+		if fives > scale {
+			scale = fives
+		}
+	}
+
+	if scale >= 0 {
+		num.Mul(num, pow10(int64(scale)))
+	} else {
+		den = new(big.Int).Mul(den, pow10(-int64(scale)))
+	}
+
+	*dst = Numeric{Int: quoRound(num, den, NumericRoundHalfUp), Exp: -scale, Status: Present}
+	return nil
+}
+
+// setBigFloat sets dst to the shortest decimal that converts back to value at its precision.
+func (dst *Numeric) setBigFloat(value *big.Float) error {
+	if value.IsInf() {
+		if value.Sign() > 0 {
+			*dst = Numeric{Status: Present, InfinityModifier: Infinity}
+		} else {
+			*dst = Numeric{Status: Present, InfinityModifier: NegativeInfinity}
+		}
+		return nil
+	}
+
+	num, exp, err := parseNumericString(value.Text('f', -1))
+	if err != nil {
+		return err
+	}
+	*dst = Numeric{Int: num, Exp: exp, Status: Present}
+	return nil
+}
+
+// ApplyTypeModifier rounds dst half away from zero to the scale of a numeric(precision, scale) typmod. It is an error if
+// dst then has more than precision digits or is infinite.
+func (dst *Numeric) ApplyTypeModifier(typmod int32) error {
+	if dst.Status != Present || typmod < varlenaHeaderSize || dst.NaN {
+		return nil
+	}
+
+	precision := ((typmod - varlenaHeaderSize) >> 16) & 0xffff
+	scale := (((typmod - varlenaHeaderSize) & 0x7ff) ^ 1024) - 1024
+
+	if dst.InfinityModifier != None {
+		return fmt.Errorf("numeric field with precision %d, scale %d cannot hold an infinite value", precision, scale)
+	}
+
+	r := dst.Rescale(scale, NumericRoundHalfUp)
+	if new(big.Int).Abs(r.Int).Cmp(pow10(int64(precision))) >= 0 {
+		return fmt.Errorf("numeric field overflow: a field with precision %d, scale %d must round to an absolute value less than 10^%d", precision, scale, precision-scale)
+	}
+
+	*dst = r
+	return nil
+}
+
 func (dst Numeric) Get() interface{} {
 	switch dst.Status {
 	case Present:
@@ -370,12 +487,33 @@ func (src *Numeric) AssignTo(dst interface{}) error {
 				return fmt.Errorf("%d is greater than maximum value for %T", normalizedInt, *v)
 			}
 			*v = normalizedInt.Uint64()
+		case *big.Int:
+			if src.NaN || src.InfinityModifier != None {
+				return fmt.Errorf("cannot assign %v to %T", src, dst)
+			}
+			normalizedInt, err := src.toBigInt()
+			if err != nil {
+				return err
+			}
+			v.Set(normalizedInt)
 		case *big.Rat:
 			rat, err := src.toBigRat()
 			if err != nil {
 				return err
 			}
 			v.Set(rat)
+		case *big.Float:
+			if src.NaN {
+				return fmt.Errorf("cannot assign %v to %T", src, dst)
+			} else if src.InfinityModifier != None {
+				v.SetInf(src.InfinityModifier == NegativeInfinity)
+				return nil
+			}
+			rat, err := src.toBigRat()
+			if err != nil {
+				return err
+			}
+			v.SetRat(rat)
 		case *string:
 			buf, err := encodeNumericText(*src, nil)
 			if err != nil {
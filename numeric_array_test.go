@@ -8,6 +8,7 @@ import (
 
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNumericArrayTranscode(t *testing.T) {
@@ -303,3 +304,39 @@ func TestNumericArrayAssignTo(t *testing.T) {
 	}
 
 }
+
+func TestNumericArrayBig(t *testing.T) {
+	var src pgtype.NumericArray
+
+	err := src.Set([]*big.Int{big.NewInt(1), nil})
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Null, src.Elements[1].Status)
+
+	var ints []*big.Int
+	err = src.AssignTo(&ints)
+	require.NoError(t, err)
+	require.Len(t, ints, 2)
+	require.Equal(t, "1", ints[0].String())
+	require.Nil(t, ints[1])
+
+	err = src.Set([]*big.Rat{big.NewRat(1, 4), big.NewRat(-3, 2)})
+	require.NoError(t, err)
+
+	var rats []*big.Rat
+	err = src.AssignTo(&rats)
+	require.NoError(t, err)
+	require.Equal(t, []*big.Rat{big.NewRat(1, 4), big.NewRat(-3, 2)}, rats)
+
+	err = src.Set([]*big.Float{big.NewFloat(2.5), new(big.Float).SetInf(false)})
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Infinity, src.Elements[1].InfinityModifier)
+
+	var floats []*big.Float
+	err = src.AssignTo(&floats)
+	require.NoError(t, err)
+	require.Equal(t, "2.5", floats[0].Text('f', -1))
+	require.True(t, floats[1].IsInf())
+
+	err = src.AssignTo(&ints)
+	require.Error(t, err)
+}
@@ -0,0 +1,285 @@
+package pgtype
+
+import (
+	"errors"
+	"math/big"
+)
+
+var errNumericDivisionByZero = errors.New("division by zero")
+
+// NumericRoundingMode selects how Numeric values are rounded when digits are discarded.
+type NumericRoundingMode int8
+
+const (
+	// NumericRoundHalfUp rounds to the nearest value and ties away from zero. This is how PostgreSQL rounds numeric.
+	NumericRoundHalfUp NumericRoundingMode = iota
+	// NumericRoundHalfEven rounds to the nearest value and ties to the nearest even digit.
+	NumericRoundHalfEven
+	// NumericRoundDown rounds toward zero. This is how PostgreSQL truncates numeric.
+	NumericRoundDown
+	// NumericRoundUp rounds away from zero.
+	NumericRoundUp
+	// NumericRoundFloor rounds toward negative infinity.
+	NumericRoundFloor
+	// NumericRoundCeiling rounds toward positive infinity.
+	NumericRoundCeiling
+)
+
+// The arithmetic methods of Numeric follow PostgreSQL's numeric operators. If either operand is not Present the
+// result is Null. NaN is contagious and operations without a meaningful result such as Infinity - Infinity or
+// Infinity * 0 return NaN. Results are exact except for Quo, which rounds to a requested scale.
+
+// Add returns n + x.
+func (n Numeric) Add(x Numeric) Numeric {
+	if n.Status != Present || x.Status != Present {
+		return Numeric{Status: Null}
+	}
+	if n.NaN || x.NaN {
+		return Numeric{Status: Present, NaN: true}
+	}
+	if n.InfinityModifier != None || x.InfinityModifier != None {
+		if n.InfinityModifier != None && x.InfinityModifier != None && n.InfinityModifier != x.InfinityModifier {
+			return Numeric{Status: Present, NaN: true}
+		}
+		if n.InfinityModifier != None {
+			return Numeric{Status: Present, InfinityModifier: n.InfinityModifier}
+		}
+		return Numeric{Status: Present, InfinityModifier: x.InfinityModifier}
+	}
+
+	a, b, exp := n.alignedInts(x)
+	return Numeric{Int: a.Add(a, b), Exp: exp, Status: Present}
+}
+
+// Sub returns n - x.
+func (n Numeric) Sub(x Numeric) Numeric {
+	return n.Add(x.Neg())
+}
+
+// Mul returns n * x.
+func (n Numeric) Mul(x Numeric) Numeric {
+	if n.Status != Present || x.Status != Present {
+		return Numeric{Status: Null}
+	}
+	if n.NaN || x.NaN {
+		return Numeric{Status: Present, NaN: true}
+	}
+	if n.InfinityModifier != None || x.InfinityModifier != None {
+		sign := n.sign() * x.sign()
+		if sign == 0 {
+			return Numeric{Status: Present, NaN: true}
+		}
+		return Numeric{Status: Present, InfinityModifier: InfinityModifier(sign)}
+	}
+
+	return Numeric{Int: new(big.Int).Mul(n.bigInt(), x.bigInt()), Exp: n.Exp + x.Exp, Status: Present}
+}
+
+// Quo returns n / x rounded with mode to scale digits after the decimal point. A negative scale rounds to the left of
+// the decimal point. Dividing a number other than NaN by zero is an error.
+func (n Numeric) Quo(x Numeric, scale int32, mode NumericRoundingMode) (Numeric, error) {
+	if n.Status != Present || x.Status != Present {
+		return Numeric{Status: Null}, nil
+	}
+	if n.NaN || x.NaN {
+		return Numeric{Status: Present, NaN: true}, nil
+	}
+	if x.sign() == 0 {
+		return Numeric{}, errNumericDivisionByZero
+	}
+	if n.InfinityModifier != None {
+		if x.InfinityModifier != None {
+			return Numeric{Status: Present, NaN: true}, nil
+		}
+		return Numeric{Status: Present, InfinityModifier: InfinityModifier(n.sign() * x.sign())}, nil
+	}
+	if x.InfinityModifier != None {
+		return Numeric{Int: big.NewInt(0), Exp: -scale, Status: Present}, nil
+	}
+
+	// n / x = (a / b) * 10^(n.Exp - x.Exp) and the result is Int * 10^-scale.
+	num := new(big.Int).Set(n.bigInt())
+	den := new(big.Int).Set(x.bigInt())
+	if k := int64(n.Exp) - int64(x.Exp) + int64(scale); k >= 0 {
+		num.Mul(num, pow10(k))
+	} else {
+		den.Mul(den, pow10(-k))
+	}
+
+	return Numeric{Int: quoRound(num, den, mode), Exp: -scale, Status: Present}, nil
+}
+
+// Cmp compares n and x and returns -1, 0 or +1 as n is less than, equal to or greater than x. Values are ordered as
+// PostgreSQL sorts numeric: -Infinity is less than all numbers, Infinity is greater than all numbers, and NaN is equal
+// to NaN and greater than all other values. A value that is not Present is greater than all Present values.
+func (n Numeric) Cmp(x Numeric) int {
+	if rn, rx := n.cmpRank(), x.cmpRank(); rn != rx || rn != 0 {
+		switch {
+		case rn < rx:
+			return -1
+		case rn > rx:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	a, b, _ := n.alignedInts(x)
+	return a.Cmp(b)
+}
+
+// cmpRank orders the values that are not finite numbers relative to the finite numbers, which have rank 0.
+func (n Numeric) cmpRank() int {
+	switch {
+	case n.Status != Present:
+		return 3
+	case n.NaN:
+		return 2
+	default:
+		return int(n.InfinityModifier)
+	}
+}
+
+// Neg returns -n.
+func (n Numeric) Neg() Numeric {
+	if n.Status != Present {
+		return Numeric{Status: Null}
+	}
+	if n.NaN {
+		return Numeric{Status: Present, NaN: true}
+	}
+	if n.InfinityModifier != None {
+		return Numeric{Status: Present, InfinityModifier: -n.InfinityModifier}
+	}
+
+	return Numeric{Int: new(big.Int).Neg(n.bigInt()), Exp: n.Exp, Status: Present}
+}
+
+// Abs returns the absolute value of n.
+func (n Numeric) Abs() Numeric {
+	if n.sign() < 0 {
+		return n.Neg()
+	}
+	if n.Status != Present {
+		return Numeric{Status: Null}
+	}
+	if n.NaN || n.InfinityModifier != None {
+		return Numeric{Status: Present, NaN: n.NaN, InfinityModifier: n.InfinityModifier}
+	}
+
+	return Numeric{Int: new(big.Int).Set(n.bigInt()), Exp: n.Exp, Status: Present}
+}
+
+// Rescale returns n with exactly scale digits after the decimal point, rounding with mode when digits are discarded.
+// A negative scale rounds to the left of the decimal point. NaN and infinite values are returned unchanged.
+func (n Numeric) Rescale(scale int32, mode NumericRoundingMode) Numeric {
+	if n.Status != Present {
+		return Numeric{Status: Null}
+	}
+	if n.NaN || n.InfinityModifier != None {
+		return Numeric{Status: Present, NaN: n.NaN, InfinityModifier: n.InfinityModifier}
+	}
+
+	k := int64(n.Exp) + int64(scale)
+	if k >= 0 {
+		return Numeric{Int: new(big.Int).Mul(n.bigInt(), pow10(k)), Exp: -scale, Status: Present}
+	}
+
+	return Numeric{Int: quoRound(new(big.Int).Set(n.bigInt()), pow10(-k), mode), Exp: -scale, Status: Present}
+}
+
+// Round returns n rounded half away from zero to scale digits after the decimal point like PostgreSQL's round.
+func (n Numeric) Round(scale int32) Numeric {
+	return n.Rescale(scale, NumericRoundHalfUp)
+}
+
+// Trunc returns n truncated toward zero to scale digits after the decimal point like PostgreSQL's trunc.
+func (n Numeric) Trunc(scale int32) Numeric {
+	return n.Rescale(scale, NumericRoundDown)
+}
+
+// StringFixed returns n formatted with exactly scale digits after the decimal point, rounding half away from zero. A
+// negative scale rounds to the left of the decimal point. NaN and infinite values are formatted as NaN, Infinity and
+// -Infinity. A value that is not Present returns an empty string.
+func (n Numeric) StringFixed(scale int32) string {
+	if n.Status != Present {
+		return ""
+	}
+
+	r := n.Rescale(scale, NumericRoundHalfUp)
+	if scale < 0 {
+		r = r.Rescale(0, NumericRoundHalfUp)
+	}
+
+	buf, _ := encodeNumericText(r, nil)
+	return string(buf)
+}
+
+// bigInt returns n.Int treating nil as 0.
+func (n Numeric) bigInt() *big.Int {
+	if n.Int == nil {
+		return big0
+	}
+	return n.Int
+}
+
+// sign returns -1, 0 or +1 for a Present numeric other than NaN.
+func (n Numeric) sign() int {
+	if n.Status != Present || n.NaN {
+		return 0
+	}
+	if n.InfinityModifier != None {
+		return int(n.InfinityModifier)
+	}
+	return n.bigInt().Sign()
+}
+
+// alignedInts returns new copies of the integers of finite n and x scaled to their common, smaller exponent.
+func (n Numeric) alignedInts(x Numeric) (a, b *big.Int, exp int32) {
+	a = new(big.Int).Set(n.bigInt())
+	b = new(big.Int).Set(x.bigInt())
+
+	switch {
+	case n.Exp > x.Exp:
+		a.Mul(a, pow10(int64(n.Exp)-int64(x.Exp)))
+		return a, b, x.Exp
+	case n.Exp < x.Exp:
+		b.Mul(b, pow10(int64(x.Exp)-int64(n.Exp)))
+	}
+
+	return a, b, n.Exp
+}
+
+func pow10(k int64) *big.Int {
+	return new(big.Int).Exp(big10, big.NewInt(k), nil)
+}
+
+// quoRound returns num / den rounded with mode. num is modified.
+func quoRound(num, den *big.Int, mode NumericRoundingMode) *big.Int {
+	rem := new(big.Int)
+	num.QuoRem(num, den, rem)
+	if rem.Sign() == 0 {
+		return num
+	}
+
+	sign := rem.Sign() * den.Sign()
+
+	var away bool
+	switch mode {
+	case NumericRoundHalfUp, NumericRoundHalfEven:
+		c := rem.Abs(rem).Lsh(rem, 1).Cmp(new(big.Int).Abs(den))
+		away = c > 0 || c == 0 && (mode == NumericRoundHalfUp || num.Bit(0) == 1)
+	case NumericRoundUp:
+		away = true
+	case NumericRoundFloor:
+		away = sign < 0
+	case NumericRoundCeiling:
+		away = sign > 0
+	}
+
+	if away {
+		num.Add(num, big.NewInt(int64(sign)))
+	}
+
+	return num
+}
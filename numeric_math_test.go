@@ -0,0 +1,193 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustDecodeNumeric(t testing.TB, s string) pgtype.Numeric {
+	var n pgtype.Numeric
+	err := n.DecodeText(nil, []byte(s))
+	require.NoError(t, err)
+	return n
+}
+
+func requireNumericEqual(t testing.TB, expected string, actual pgtype.Numeric, msgAndArgs ...interface{}) {
+	e := mustDecodeNumeric(t, expected)
+	require.Equal(t, pgtype.Present, actual.Status, msgAndArgs...)
+	require.Equal(t, e.NaN, actual.NaN, msgAndArgs...)
+	require.Equal(t, e.InfinityModifier, actual.InfinityModifier, msgAndArgs...)
+	require.Zero(t, e.Cmp(actual), msgAndArgs...)
+}
+
+func TestNumericAddSubMul(t *testing.T) {
+	tests := []struct {
+		a, b          string
+		sum, diff, pr string
+	}{
+		{a: "1", b: "2", sum: "3", diff: "-1", pr: "2"},
+		{a: "1.5", b: "-0.25", sum: "1.25", diff: "1.75", pr: "-0.375"},
+		{a: "100", b: "0.001", sum: "100.001", diff: "99.999", pr: "0.1"},
+		{a: "123456789012345678901234567890", b: "0.000000000000000000001", sum: "123456789012345678901234567890.000000000000000000001", diff: "123456789012345678901234567889.999999999999999999999", pr: "123456789.01234567890123456789"},
+		{a: "NaN", b: "1", sum: "NaN", diff: "NaN", pr: "NaN"},
+		{a: "1", b: "NaN", sum: "NaN", diff: "NaN", pr: "NaN"},
+		{a: "Infinity", b: "1", sum: "Infinity", diff: "Infinity", pr: "Infinity"},
+		{a: "Infinity", b: "-1", sum: "Infinity", diff: "Infinity", pr: "-Infinity"},
+		{a: "1", b: "Infinity", sum: "Infinity", diff: "-Infinity", pr: "Infinity"},
+		{a: "Infinity", b: "Infinity", sum: "Infinity", diff: "NaN", pr: "Infinity"},
+		{a: "Infinity", b: "-Infinity", sum: "NaN", diff: "Infinity", pr: "-Infinity"},
+		{a: "-Infinity", b: "0", sum: "-Infinity", diff: "-Infinity", pr: "NaN"},
+	}
+
+	for i, tt := range tests {
+		a, b := mustDecodeNumeric(t, tt.a), mustDecodeNumeric(t, tt.b)
+		requireNumericEqual(t, tt.sum, a.Add(b), "%d: %s + %s", i, tt.a, tt.b)
+		requireNumericEqual(t, tt.diff, a.Sub(b), "%d: %s - %s", i, tt.a, tt.b)
+		requireNumericEqual(t, tt.pr, a.Mul(b), "%d: %s * %s", i, tt.a, tt.b)
+	}
+
+	one := mustDecodeNumeric(t, "1")
+	null := pgtype.Numeric{Status: pgtype.Null}
+	assert.Equal(t, pgtype.Null, one.Add(null).Status)
+	assert.Equal(t, pgtype.Null, null.Sub(one).Status)
+	assert.Equal(t, pgtype.Null, one.Mul(null).Status)
+
+	// Operands are not modified.
+	a := mustDecodeNumeric(t, "1.5")
+	a.Add(a)
+	a.Neg()
+	requireNumericEqual(t, "1.5", a)
+}
+
+func TestNumericQuo(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		scale  int32
+		mode   pgtype.NumericRoundingMode
+		result string
+	}{
+		{a: "1", b: "3", scale: 5, mode: pgtype.NumericRoundHalfUp, result: "0.33333"},
+		{a: "2", b: "3", scale: 5, mode: pgtype.NumericRoundHalfUp, result: "0.66667"},
+		{a: "2", b: "3", scale: 5, mode: pgtype.NumericRoundDown, result: "0.66666"},
+		{a: "-2", b: "3", scale: 0, mode: pgtype.NumericRoundFloor, result: "-1"},
+		{a: "-2", b: "3", scale: 0, mode: pgtype.NumericRoundCeiling, result: "0"},
+		{a: "1", b: "-3", scale: 0, mode: pgtype.NumericRoundUp, result: "-1"},
+		{a: "5", b: "2", scale: 0, mode: pgtype.NumericRoundHalfUp, result: "3"},
+		{a: "-5", b: "2", scale: 0, mode: pgtype.NumericRoundHalfUp, result: "-3"},
+		{a: "5", b: "2", scale: 0, mode: pgtype.NumericRoundHalfEven, result: "2"},
+		{a: "7", b: "2", scale: 0, mode: pgtype.NumericRoundHalfEven, result: "4"},
+		{a: "-7", b: "2", scale: 0, mode: pgtype.NumericRoundHalfEven, result: "-4"},
+		{a: "12345", b: "0.01", scale: -3, mode: pgtype.NumericRoundHalfUp, result: "1235000"},
+		{a: "1.000", b: "0.0003", scale: 2, mode: pgtype.NumericRoundHalfUp, result: "3333.33"},
+		{a: "10", b: "4", scale: 3, mode: pgtype.NumericRoundHalfUp, result: "2.500"},
+		{a: "NaN", b: "0", scale: 2, mode: pgtype.NumericRoundHalfUp, result: "NaN"},
+		{a: "Infinity", b: "-2", scale: 2, mode: pgtype.NumericRoundHalfUp, result: "-Infinity"},
+		{a: "Infinity", b: "Infinity", scale: 2, mode: pgtype.NumericRoundHalfUp, result: "NaN"},
+		{a: "5", b: "-Infinity", scale: 2, mode: pgtype.NumericRoundHalfUp, result: "0.00"},
+	}
+
+	for i, tt := range tests {
+		a, b := mustDecodeNumeric(t, tt.a), mustDecodeNumeric(t, tt.b)
+		r, err := a.Quo(b, tt.scale, tt.mode)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, r.StringFixed(tt.scale), "%d: %s / %s", i, tt.a, tt.b)
+		if !r.NaN && r.InfinityModifier == pgtype.None {
+			require.Equalf(t, -tt.scale, r.Exp, "%d", i)
+		}
+	}
+
+	for i, s := range []string{"1", "0", "Infinity", "-Infinity"} {
+		_, err := mustDecodeNumeric(t, s).Quo(mustDecodeNumeric(t, "0.00"), 2, pgtype.NumericRoundHalfUp)
+		require.Errorf(t, err, "%d", i)
+	}
+}
+
+func TestNumericCmp(t *testing.T) {
+	ordered := []pgtype.Numeric{
+		mustDecodeNumeric(t, "-Infinity"),
+		mustDecodeNumeric(t, "-1000000000000000000000"),
+		mustDecodeNumeric(t, "-1.5"),
+		mustDecodeNumeric(t, "0"),
+		mustDecodeNumeric(t, "0.000001"),
+		mustDecodeNumeric(t, "1"),
+		mustDecodeNumeric(t, "1.01"),
+		mustDecodeNumeric(t, "100"),
+		mustDecodeNumeric(t, "Infinity"),
+		mustDecodeNumeric(t, "NaN"),
+		{Status: pgtype.Null},
+	}
+
+	for i := range ordered {
+		for j := range ordered {
+			expected := 0
+			if i < j {
+				expected = -1
+			} else if i > j {
+				expected = 1
+			}
+			assert.Equalf(t, expected, ordered[i].Cmp(ordered[j]), "%d cmp %d", i, j)
+		}
+	}
+
+	assert.Zero(t, mustDecodeNumeric(t, "1.50").Cmp(mustDecodeNumeric(t, "1.5")))
+	assert.Zero(t, mustDecodeNumeric(t, "100").Cmp(pgtype.Numeric{Int: mustParseBigInt(t, "100"), Status: pgtype.Present}))
+}
+
+func TestNumericNegAbs(t *testing.T) {
+	tests := []struct {
+		src, neg, abs string
+	}{
+		{src: "1.5", neg: "-1.5", abs: "1.5"},
+		{src: "-1.5", neg: "1.5", abs: "1.5"},
+		{src: "0", neg: "0", abs: "0"},
+		{src: "NaN", neg: "NaN", abs: "NaN"},
+		{src: "Infinity", neg: "-Infinity", abs: "Infinity"},
+		{src: "-Infinity", neg: "Infinity", abs: "Infinity"},
+	}
+
+	for i, tt := range tests {
+		n := mustDecodeNumeric(t, tt.src)
+		requireNumericEqual(t, tt.neg, n.Neg(), "%d", i)
+		requireNumericEqual(t, tt.abs, n.Abs(), "%d", i)
+	}
+
+	assert.Equal(t, pgtype.Null, pgtype.Numeric{Status: pgtype.Null}.Neg().Status)
+	assert.Equal(t, pgtype.Null, pgtype.Numeric{Status: pgtype.Null}.Abs().Status)
+}
+
+func TestNumericRoundTruncStringFixed(t *testing.T) {
+	tests := []struct {
+		src   string
+		scale int32
+		round string
+		trunc string
+	}{
+		{src: "1.2345", scale: 2, round: "1.23", trunc: "1.23"},
+		{src: "1.235", scale: 2, round: "1.24", trunc: "1.23"},
+		{src: "-1.235", scale: 2, round: "-1.24", trunc: "-1.23"},
+		{src: "2.5", scale: 0, round: "3", trunc: "2"},
+		{src: "-2.5", scale: 0, round: "-3", trunc: "-2"},
+		{src: "1.5", scale: 4, round: "1.5000", trunc: "1.5000"},
+		{src: "1250", scale: -2, round: "1300", trunc: "1200"},
+		{src: "-49", scale: -2, round: "0", trunc: "0"},
+		{src: "0.001", scale: 2, round: "0.00", trunc: "0.00"},
+		{src: "-0.001", scale: 2, round: "0.00", trunc: "0.00"},
+		{src: "NaN", scale: 2, round: "NaN", trunc: "NaN"},
+		{src: "-Infinity", scale: 2, round: "-Infinity", trunc: "-Infinity"},
+	}
+
+	for i, tt := range tests {
+		n := mustDecodeNumeric(t, tt.src)
+		assert.Equalf(t, tt.round, n.StringFixed(tt.scale), "%d", i)
+		assert.Equalf(t, tt.round, n.Round(tt.scale).StringFixed(tt.scale), "%d", i)
+		assert.Equalf(t, tt.trunc, n.Trunc(tt.scale).StringFixed(tt.scale), "%d", i)
+	}
+
+	assert.Equal(t, "", pgtype.Numeric{Status: pgtype.Null}.StringFixed(2))
+	assert.Equal(t, "0.125", mustDecodeNumeric(t, "0.125").Rescale(3, pgtype.NumericRoundHalfEven).StringFixed(3))
+	assert.Equal(t, "0.12", mustDecodeNumeric(t, "0.125").Rescale(2, pgtype.NumericRoundHalfEven).StringFixed(2))
+	assert.Equal(t, "0.13", mustDecodeNumeric(t, "0.125").Rescale(2, pgtype.NumericRoundCeiling).StringFixed(2))
+}
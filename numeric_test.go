@@ -9,6 +9,7 @@ import (
 
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/require"
 )
 
 // For test purposes only. Note that it does not normalize values. e.g. (Int: 1, Exp: 3) will not equal (Int: 1000, Exp: 0)
@@ -230,6 +231,18 @@ func TestNumericSet(t *testing.T) {
 		{source: pgtype.NegativeInfinity, result: &pgtype.Numeric{InfinityModifier: pgtype.NegativeInfinity, Status: pgtype.Present}},
 		{source: math.Inf(-1), result: &pgtype.Numeric{Status: pgtype.Present, InfinityModifier: pgtype.NegativeInfinity}},
 		{source: float32(math.Inf(1)), result: &pgtype.Numeric{Status: pgtype.Present, InfinityModifier: pgtype.Infinity}},
+		{source: big.NewInt(-42), result: &pgtype.Numeric{Int: big.NewInt(-42), Status: pgtype.Present}},
+		{source: *big.NewInt(42), result: &pgtype.Numeric{Int: big.NewInt(42), Status: pgtype.Present}},
+		{source: (*big.Int)(nil), result: &pgtype.Numeric{Status: pgtype.Null}},
+		{source: big.NewRat(5, 4), result: &pgtype.Numeric{Int: big.NewInt(125), Exp: -2, Status: pgtype.Present}},
+		{source: big.NewRat(-1, 3), result: &pgtype.Numeric{Int: mustParseBigInt(t, "-33333333333333333333"), Exp: -20, Status: pgtype.Present}},
+		{source: *big.NewRat(7, 1), result: &pgtype.Numeric{Int: big.NewInt(7), Exp: 0, Status: pgtype.Present}},
+		{source: (*big.Rat)(nil), result: &pgtype.Numeric{Status: pgtype.Null}},
+		{source: big.NewFloat(1.25), result: &pgtype.Numeric{Int: big.NewInt(125), Exp: -2, Status: pgtype.Present}},
+		{source: *big.NewFloat(0.1), result: &pgtype.Numeric{Int: big.NewInt(1), Exp: -1, Status: pgtype.Present}},
+		{source: new(big.Float).SetPrec(24).SetFloat64(0.1), result: &pgtype.Numeric{Int: big.NewInt(1), Exp: -1, Status: pgtype.Present}},
+		{source: new(big.Float).SetInf(true), result: &pgtype.Numeric{Status: pgtype.Present, InfinityModifier: pgtype.NegativeInfinity}},
+		{source: (*big.Float)(nil), result: &pgtype.Numeric{Status: pgtype.Null}},
 	}
 
 	for i, tt := range successfulTests {
@@ -468,3 +481,105 @@ func TestNumericFloat64Exhaustive(t *testing.T) {
 		}
 	}
 }
+
+func TestNumericSetBigRat(t *testing.T) {
+	tests := []struct {
+		src      *big.Rat
+		maxScale int32
+		result   *pgtype.Numeric
+	}{
+		{src: big.NewRat(2, 3), maxScale: 4, result: &pgtype.Numeric{Int: big.NewInt(6667), Exp: -4, Status: pgtype.Present}},
+		{src: big.NewRat(-2, 3), maxScale: 0, result: &pgtype.Numeric{Int: big.NewInt(-1), Exp: 0, Status: pgtype.Present}},
+		{src: big.NewRat(20000, 3), maxScale: -2, result: &pgtype.Numeric{Int: big.NewInt(67), Exp: 2, Status: pgtype.Present}},
+		{src: big.NewRat(1, 1024), maxScale: 2, result: &pgtype.Numeric{Int: big.NewInt(9765625), Exp: -10, Status: pgtype.Present}},
+		{src: nil, maxScale: 2, result: &pgtype.Numeric{Status: pgtype.Null}},
+	}
+
+	for i, tt := range tests {
+		var r pgtype.Numeric
+		err := r.SetBigRat(tt.src, tt.maxScale)
+		require.NoErrorf(t, err, "%d", i)
+		require.Truef(t, numericEqual(&r, tt.result), "%d: %v", i, r)
+	}
+}
+
+func TestNumericAssignToBig(t *testing.T) {
+	n := &pgtype.Numeric{Int: big.NewInt(12345), Exp: -2, Status: pgtype.Present}
+
+	var bi big.Int
+	require.Error(t, n.AssignTo(&bi))
+
+	require.NoError(t, (&pgtype.Numeric{Int: big.NewInt(12345), Exp: 2, Status: pgtype.Present}).AssignTo(&bi))
+	require.Equal(t, "1234500", bi.String())
+
+	var br big.Rat
+	require.NoError(t, n.AssignTo(&br))
+	require.Equal(t, "2469/20", br.String())
+
+	bf := new(big.Float).SetPrec(200)
+	require.NoError(t, n.AssignTo(bf))
+	require.Equal(t, uint(200), bf.Prec())
+	require.Equal(t, "123.45", bf.Text('f', -1))
+
+	var pbi *big.Int
+	product := n.Mul(pgtype.Numeric{Int: big.NewInt(100), Status: pgtype.Present})
+	require.NoError(t, product.AssignTo(&pbi))
+	require.Equal(t, "12345", pbi.String())
+
+	require.NoError(t, (&pgtype.Numeric{Status: pgtype.Null}).AssignTo(&pbi))
+	require.Nil(t, pbi)
+
+	require.NoError(t, (&pgtype.Numeric{Status: pgtype.Present, InfinityModifier: pgtype.NegativeInfinity}).AssignTo(bf))
+	require.True(t, bf.IsInf())
+	require.Equal(t, -1, bf.Sign())
+
+	for _, src := range []*pgtype.Numeric{
+		{Status: pgtype.Present, NaN: true},
+		{Status: pgtype.Present, InfinityModifier: pgtype.Infinity},
+	} {
+		require.Error(t, src.AssignTo(&bi))
+		require.Error(t, src.AssignTo(&br))
+	}
+	require.Error(t, (&pgtype.Numeric{Status: pgtype.Present, NaN: true}).AssignTo(bf))
+}
+
+func TestNumericApplyTypeModifier(t *testing.T) {
+	numericTypmod := func(precision, scale int32) int32 { return (precision<<16 | scale&0x7ff) + 4 }
+
+	tests := []struct {
+		src    string
+		typmod int32
+		result string
+	}{
+		{src: "1.005", typmod: numericTypmod(10, 2), result: "1.01"},
+		{src: "-1.005", typmod: numericTypmod(10, 2), result: "-1.01"},
+		{src: "12", typmod: numericTypmod(10, 2), result: "12.00"},
+		{src: "99999999.994", typmod: numericTypmod(10, 2), result: "99999999.99"},
+		{src: "1250", typmod: numericTypmod(5, -2), result: "1300"},
+		{src: "0.0012345", typmod: numericTypmod(3, 5), result: "0.00123"},
+		{src: "12.345", typmod: -1, result: "12.345"},
+		{src: "NaN", typmod: numericTypmod(10, 2), result: "NaN"},
+	}
+
+	for i, tt := range tests {
+		n := mustDecodeNumeric(t, tt.src)
+		err := n.ApplyTypeModifier(tt.typmod)
+		require.NoErrorf(t, err, "%d", i)
+		buf, err := n.EncodeText(nil, nil)
+		require.NoError(t, err)
+		requireNumericEqual(t, tt.result, n, "%d", i)
+		if tt.typmod != -1 && !n.NaN {
+			require.Equalf(t, tt.result, n.StringFixed(-n.Exp), "%d: %s", i, buf)
+		}
+	}
+
+	for i, src := range []string{"99999999.995", "-100000000", "Infinity", "-Infinity"} {
+		n := mustDecodeNumeric(t, src)
+		err := n.ApplyTypeModifier(numericTypmod(10, 2))
+		require.Errorf(t, err, "%d", i)
+	}
+
+	n := pgtype.Numeric{Status: pgtype.Null}
+	require.NoError(t, n.ApplyTypeModifier(numericTypmod(1, 0)))
+	require.Equal(t, pgtype.Numeric{Status: pgtype.Null}, n)
+}
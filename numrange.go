@@ -265,3 +265,182 @@ func (dst *Numrange) Scan(src interface{}) error {
 func (src Numrange) Value() (driver.Value, error) {
 	return EncodeValueText(src)
 }
+
+func (src Numrange) rangeValue() (rangeValue[Numeric], error) {
+	return newRangeValue(src.Lower, src.Upper, src.LowerType, src.UpperType, numericRangeElementOps)
+}
+
+func newNumrangeFromRangeValue(r rangeValue[Numeric]) Numrange {
+	dst := Numrange{Status: Present}
+	dst.LowerType, dst.UpperType = r.boundTypes()
+	if dst.LowerType == Inclusive || dst.LowerType == Exclusive {
+		dst.Lower = r.lower.value
+	}
+	if dst.UpperType == Inclusive || dst.UpperType == Exclusive {
+		dst.Upper = r.upper.value
+	}
+	return dst
+}
+
+// Canonicalize returns src in the canonical form PostgreSQL uses. A range that contains no values is empty and a
+// range of a discrete element type has an inclusive lower bound and an exclusive upper bound.
+func (src Numrange) Canonicalize() (Numrange, error) {
+	if src.Status != Present {
+		return Numrange{Status: src.Status}, nil
+	}
+
+	r, err := src.rangeValue()
+	if err != nil {
+		return Numrange{}, err
+	}
+	return newNumrangeFromRangeValue(r), nil
+}
+
+// rangeValuePair returns the range values of src and x.
+func (src Numrange) rangeValuePair(x Numrange) (rangeValue[Numeric], rangeValue[Numeric], error) {
+	r, err := src.rangeValue()
+	if err != nil {
+		return r, r, err
+	}
+	xr, err := x.rangeValue()
+	return r, xr, err
+}
+
+// IsEmpty reports whether src contains no values. It is an error if src is not a valid range.
+func (src Numrange) IsEmpty() (bool, error) {
+	if src.Status != Present {
+		return false, nil
+	}
+	r, err := src.rangeValue()
+	if err != nil {
+		return false, err
+	}
+	return r.empty, nil
+}
+
+// Contains reports whether src contains elem like the PostgreSQL @> operator. It is an error if src is not a valid
+// range.
+func (src Numrange) Contains(elem Numeric) (bool, error) {
+	if src.Status != Present || elem.Status != Present {
+		return false, nil
+	}
+	r, err := src.rangeValue()
+	if err != nil {
+		return false, err
+	}
+	return r.containsElem(elem, numericRangeElementOps), nil
+}
+
+// ContainsRange reports whether src contains x like the PostgreSQL @> operator. It is an error if either range is
+// not a valid range.
+func (src Numrange) ContainsRange(x Numrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.containsRange(xr, numericRangeElementOps), nil
+}
+
+// Overlaps reports whether src and x have values in common like the PostgreSQL && operator. It is an error if either
+// range is not a valid range.
+func (src Numrange) Overlaps(x Numrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.overlaps(xr, numericRangeElementOps), nil
+}
+
+// Adjacent reports whether src and x are adjacent like the PostgreSQL -|- operator. It is an error if either range is
+// not a valid range.
+func (src Numrange) Adjacent(x Numrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.adjacent(xr, numericRangeElementOps), nil
+}
+
+// StrictlyLeftOf reports whether all values of src are less than all values of x like the PostgreSQL << operator. It
+// is an error if either range is not a valid range.
+func (src Numrange) StrictlyLeftOf(x Numrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.before(xr, numericRangeElementOps), nil
+}
+
+// StrictlyRightOf reports whether all values of src are greater than all values of x like the PostgreSQL >>
+// operator. It is an error if either range is not a valid range.
+func (src Numrange) StrictlyRightOf(x Numrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.after(xr, numericRangeElementOps), nil
+}
+
+// Union returns the union of src and x like the PostgreSQL + operator. It is an error if the ranges neither overlap
+// nor are adjacent. If either range is not Present the result is Null.
+func (src Numrange) Union(x Numrange) (Numrange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Numrange{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Numrange{}, err
+	}
+
+	result, err := r.union(xr, numericRangeElementOps)
+	if err != nil {
+		return Numrange{}, err
+	}
+	return newNumrangeFromRangeValue(result), nil
+}
+
+// Intersect returns the intersection of src and x like the PostgreSQL * operator. If either range is not Present the
+// result is Null. It is an error if either range is not a valid range.
+func (src Numrange) Intersect(x Numrange) (Numrange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Numrange{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Numrange{}, err
+	}
+	return newNumrangeFromRangeValue(r.intersect(xr, numericRangeElementOps)), nil
+}
+
+// Difference returns the values of src that are not in x like the PostgreSQL - operator. It is an error if x is
+// strictly inside src so the result would be two ranges. If either range is not Present the result is Null.
+func (src Numrange) Difference(x Numrange) (Numrange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Numrange{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Numrange{}, err
+	}
+
+	result, err := r.difference(xr, numericRangeElementOps)
+	if err != nil {
+		return Numrange{}, err
+	}
+	return newNumrangeFromRangeValue(result), nil
+}
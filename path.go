@@ -17,7 +17,20 @@ type Path struct {
 	Status Status
 }
 
+// Set converts src to dst. src may be nil or a string in WKT or the PostgreSQL text format.
 func (dst *Path) Set(src interface{}) error {
+	if src == nil {
+		*dst = Path{Status: Null}
+		return nil
+	}
+
+	if value, ok := src.(string); ok {
+		if isWKT(value) {
+			return dst.UnmarshalWKT([]byte(value))
+		}
+		return dst.DecodeText(nil, []byte(value))
+	}
+
 	return fmt.Errorf("cannot convert %v to Path", src)
 }
 
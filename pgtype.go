@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"net/netip"
 	"reflect"
 	"time"
 )
@@ -178,6 +179,18 @@ type ParamFormatPreferrer interface {
 	PreferredParamFormat() int16
 }
 
+// TypeModifierApplier is implemented by types whose values can be constrained by a PostgreSQL type modifier such as
+// the 2 in numeric(10,2) or the 20 in varchar(20).
+type TypeModifierApplier interface {
+	// ApplyTypeModifier validates and coerces the value to typmod the same way PostgreSQL does on assignment to a
+	// column of that type. typmod is the raw atttypmod, e.g. from FieldDescription.TypeModifier or pg_attribute. A
+	// typmod of -1 means the type is unconstrained and leaves the value unchanged. NULL values are never changed.
+	ApplyTypeModifier(typmod int32) error
+}
+
+// varlenaHeaderSize is added by PostgreSQL to the type modifier of numeric, varchar and bpchar.
+const varlenaHeaderSize = 4
+
 type BinaryDecoder interface {
 	// DecodeBinary decodes src into BinaryDecoder. If src is nil then the
 	// original SQL value is NULL. BinaryDecoder takes ownership of src. The
@@ -241,6 +254,12 @@ type ConnInfo struct {
 	oidToResultFormatCode map[uint32]int16
 
 	reflectTypeToDataType map[reflect.Type]*DataType
+
+	// replacedOIDs are the OIDs of standard types whose data type has been replaced with a different Value type.
+	replacedOIDs map[uint32]struct{}
+
+	dateStyle DateStyle
+	timeZone  *time.Location
 }
 
 func newConnInfo() *ConnInfo {
@@ -250,6 +269,7 @@ func newConnInfo() *ConnInfo {
 		reflectTypeToName:     make(map[reflect.Type]string),
 		oidToParamFormatCode:  make(map[uint32]int16),
 		oidToResultFormatCode: make(map[uint32]int16),
+		replacedOIDs:          make(map[uint32]struct{}),
 	}
 }
 
@@ -363,6 +383,8 @@ func NewConnInfo() *ConnInfo {
 	registerDefaultPgTypeVariants("inet", "_inet", net.IP{})
 	ci.RegisterDefaultPgType((*net.IPNet)(nil), "cidr")
 	ci.RegisterDefaultPgType([]*net.IPNet(nil), "_cidr")
+	registerDefaultPgTypeVariants("inet", "_inet", netip.Addr{})
+	registerDefaultPgTypeVariants("cidr", "_cidr", netip.Prefix{})
 
 	return ci
 }
@@ -385,6 +407,12 @@ func (ci *ConnInfo) RegisterDataType(t DataType) {
 	ci.oidToDataType[t.OID] = &t
 	ci.nameToDataType[t.Name] = &t
 
+	if standard, ok := nameValues[t.Name]; ok && reflect.TypeOf(t.Value) != reflect.TypeOf(standard) {
+		ci.replacedOIDs[t.OID] = struct{}{}
+	} else {
+		delete(ci.replacedOIDs, t.OID)
+	}
+
 	{
 		var formatCode int16
 		if pfp, ok := t.Value.(ParamFormatPreferrer); ok {
@@ -498,6 +526,9 @@ func (ci *ConnInfo) DeepCopy() *ConnInfo {
 		ci2.reflectTypeToName[t] = n
 	}
 
+	ci2.dateStyle = ci.dateStyle
+	ci2.timeZone = ci.timeZone
+
 	return ci2
 }
 
@@ -519,6 +550,22 @@ func (scanPlanDstBinaryDecoder) Scan(ci *ConnInfo, oid uint32, formatCode int16,
 	return newPlan.Scan(ci, oid, formatCode, src, dst)
 }
 
+// oidDecoder is implemented by values that choose their decoder from the OID of the source, such as Nullable.
+type oidDecoder interface {
+	decodeOID(ci *ConnInfo, oid uint32, formatCode int16, src []byte) error
+}
+
+type scanPlanDstOIDDecoder struct{}
+
+func (scanPlanDstOIDDecoder) Scan(ci *ConnInfo, oid uint32, formatCode int16, src []byte, dst interface{}) error {
+	if d, ok := (dst).(oidDecoder); ok {
+		return d.decodeOID(ci, oid, formatCode, src)
+	}
+
+	newPlan := ci.PlanScan(oid, formatCode, dst)
+	return newPlan.Scan(ci, oid, formatCode, src, dst)
+}
+
 type scanPlanDstTextDecoder struct{}
 
 func (plan scanPlanDstTextDecoder) Scan(ci *ConnInfo, oid uint32, formatCode int16, src []byte, dst interface{}) error {
@@ -809,38 +856,54 @@ func isScanner(dst interface{}) bool {
 
 // PlanScan prepares a plan to scan a value into dst.
 func (ci *ConnInfo) PlanScan(oid uint32, formatCode int16, dst interface{}) ScanPlan {
+	// The fast path plans bypass the data type registered for oid. They cannot be used if it has been replaced, for
+	// example by a type that scans NULL as a zero value.
+	fastPath := true
+	if len(ci.replacedOIDs) > 0 {
+		_, replaced := ci.replacedOIDs[oid]
+		fastPath = !replaced
+	}
+
+	if _, ok := dst.(oidDecoder); ok {
+		return scanPlanDstOIDDecoder{}
+	}
+
 	switch formatCode {
 	case BinaryFormatCode:
 		switch dst.(type) {
 		case *string:
-			switch oid {
-			case TextOID, VarcharOID:
-				return scanPlanString{}
+			if fastPath {
+				switch oid {
+				case TextOID, VarcharOID:
+					return scanPlanString{}
+				}
 			}
 		case *int16:
-			if oid == Int2OID {
+			if fastPath && oid == Int2OID {
 				return scanPlanBinaryInt16{}
 			}
 		case *int32:
-			if oid == Int4OID {
+			if fastPath && oid == Int4OID {
 				return scanPlanBinaryInt32{}
 			}
 		case *int64:
-			if oid == Int8OID {
+			if fastPath && oid == Int8OID {
 				return scanPlanBinaryInt64{}
 			}
 		case *float32:
-			if oid == Float4OID {
+			if fastPath && oid == Float4OID {
 				return scanPlanBinaryFloat32{}
 			}
 		case *float64:
-			if oid == Float8OID {
+			if fastPath && oid == Float8OID {
 				return scanPlanBinaryFloat64{}
 			}
 		case *[]byte:
-			switch oid {
-			case ByteaOID, TextOID, VarcharOID, JSONOID:
-				return scanPlanBinaryBytes{}
+			if fastPath {
+				switch oid {
+				case ByteaOID, TextOID, VarcharOID, JSONOID:
+					return scanPlanBinaryBytes{}
+				}
 			}
 		case BinaryDecoder:
 			return scanPlanDstBinaryDecoder{}
@@ -848,9 +911,11 @@ func (ci *ConnInfo) PlanScan(oid uint32, formatCode int16, dst interface{}) Scan
 	case TextFormatCode:
 		switch dst.(type) {
 		case *string:
-			return scanPlanString{}
+			if fastPath {
+				return scanPlanString{}
+			}
 		case *[]byte:
-			if oid != ByteaOID {
+			if fastPath && oid != ByteaOID {
 				return scanPlanBinaryBytes{}
 			}
 		case TextDecoder:
@@ -2,12 +2,15 @@ package pgtype_test
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"errors"
 	"net"
+	"net/netip"
 	"testing"
 
 	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/testutil"
 	"github.com/jackc/pgx/v4"
 	_ "github.com/jackc/pgx/v4/stdlib"
 	_ "github.com/lib/pq"
@@ -88,6 +91,27 @@ func TestConnInfoParamFormatCodeForOID(t *testing.T) {
 	assert.Equal(t, int16(pgtype.BinaryFormatCode), ci.ParamFormatCodeForOID(pgtype.Int4OID))
 }
 
+func TestConnInfoDataTypeForValueNetip(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	for i, tt := range []struct {
+		value interface{}
+		name  string
+	}{
+		{value: netip.Addr{}, name: "inet"},
+		{value: &netip.Addr{}, name: "inet"},
+		{value: []netip.Addr{}, name: "_inet"},
+		{value: netip.Prefix{}, name: "cidr"},
+		{value: &netip.Prefix{}, name: "cidr"},
+		{value: []netip.Prefix{}, name: "_cidr"},
+	} {
+		dt, ok := ci.DataTypeForValue(tt.value)
+		if assert.Truef(t, ok, "%d", i) {
+			assert.Equalf(t, tt.name, dt.Name, "%d", i)
+		}
+	}
+}
+
 func TestConnInfoScanNilIsNoOp(t *testing.T) {
 	ci := pgtype.NewConnInfo()
 
@@ -203,6 +227,59 @@ func TestConnInfoScanUnregisteredOIDToCustomType(t *testing.T) {
 	assert.Nil(t, pCt)
 }
 
+// zeroNullInt4 is an int4 data type that scans NULL as 0 like zeronull.Int4.
+type zeroNullInt4 int32
+
+func (dst *zeroNullInt4) Set(src interface{}) error {
+	var v pgtype.Int4
+	err := v.Set(src)
+	*dst = zeroNullInt4(v.Int)
+	return err
+}
+
+func (src zeroNullInt4) Get() interface{} {
+	return int32(src)
+}
+
+func (src *zeroNullInt4) AssignTo(dst interface{}) error {
+	v := pgtype.Int4{Int: int32(*src), Status: pgtype.Present}
+	return v.AssignTo(dst)
+}
+
+func (dst *zeroNullInt4) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	var v pgtype.Int4
+	err := v.DecodeBinary(ci, src)
+	*dst = zeroNullInt4(v.Int)
+	return err
+}
+
+func TestConnInfoScanReplacedDataType(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	// The fast path for the standard int4 data type cannot scan NULL into an *int32.
+	var n int32
+	err := ci.Scan(pgtype.Int4OID, pgx.BinaryFormatCode, nil, &n)
+	require.Error(t, err)
+
+	// A replaced data type must be used instead of the fast path.
+	ci.RegisterDataType(pgtype.DataType{Value: new(zeroNullInt4), Name: "int4", OID: pgtype.Int4OID})
+	for _, ci := range []*pgtype.ConnInfo{ci, ci.DeepCopy()} {
+		n = 42
+		err = ci.Scan(pgtype.Int4OID, pgx.BinaryFormatCode, nil, &n)
+		require.NoError(t, err)
+		assert.EqualValues(t, 0, n)
+
+		err = ci.Scan(pgtype.Int4OID, pgx.BinaryFormatCode, []byte{0, 0, 0, 7}, &n)
+		require.NoError(t, err)
+		assert.EqualValues(t, 7, n)
+	}
+
+	// Registering the standard data type again restores the fast path.
+	ci.RegisterDataType(pgtype.DataType{Value: &pgtype.Int4{}, Name: "int4", OID: pgtype.Int4OID})
+	err = ci.Scan(pgtype.Int4OID, pgx.BinaryFormatCode, nil, &n)
+	require.Error(t, err)
+}
+
 func TestConnInfoScanUnknownOIDTextFormat(t *testing.T) {
 	ci := pgtype.NewConnInfo()
 
@@ -361,3 +438,45 @@ func TestScanPlanInterface(t *testing.T) {
 	err := plan.Scan(ci, pgtype.Int2OID, pgtype.BinaryFormatCode, src, v)
 	assert.Error(t, err)
 }
+
+func TestTypeModifierApplierMatchesServer(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	_, err := conn.Exec(context.Background(), `create temporary table typmod_test (
+	n numeric(10,2),
+	vc varchar(5),
+	c char(5),
+	b bit(8),
+	ts timestamp(3),
+	i interval second(0)
+)`)
+	require.NoError(t, err)
+
+	inputs := []string{"12.345", "abc  ", "ab", "10101010", "2020-01-02 03:04:05.123556", "3 days 04:05:06.5"}
+	_, err = conn.Exec(context.Background(), "insert into typmod_test values ($1, $2, $3, $4, $5, $6)",
+		inputs[0], inputs[1], inputs[2], inputs[3], inputs[4], inputs[5])
+	require.NoError(t, err)
+
+	rows, err := conn.Query(context.Background(), "select * from typmod_test", pgx.QueryResultFormats{pgx.TextFormatCode})
+	require.NoError(t, err)
+	require.True(t, rows.Next())
+	fields := rows.FieldDescriptions()
+	expected := rows.RawValues()
+	expectedText := make([]string, len(expected))
+	for i := range expected {
+		expectedText[i] = string(expected[i])
+	}
+	rows.Close()
+	require.NoError(t, rows.Err())
+
+	values := []pgtype.ValueTranscoder{&pgtype.Numeric{}, &pgtype.Varchar{}, &pgtype.BPChar{}, &pgtype.Bit{}, &pgtype.Timestamp{}, &pgtype.Interval{}}
+	for i, v := range values {
+		require.NoErrorf(t, v.DecodeText(nil, []byte(inputs[i])), "%d", i)
+		require.NoErrorf(t, v.(pgtype.TypeModifierApplier).ApplyTypeModifier(fields[i].TypeModifier), "%d", i)
+
+		e := pgtype.NewValue(v).(pgtype.ValueTranscoder)
+		require.NoErrorf(t, e.DecodeText(nil, []byte(expectedText[i])), "%d", i)
+		require.Equalf(t, e.Get(), v.Get(), "%d", i)
+	}
+}
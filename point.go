@@ -31,6 +31,9 @@ func (dst *Point) Set(src interface{}) error {
 	var p *Point
 	switch value := src.(type) {
 	case string:
+		if isWKT(value) {
+			return dst.UnmarshalWKT([]byte(value))
+		}
 		p, err = parsePoint([]byte(value))
 	case []byte:
 		p, err = parsePoint(value)
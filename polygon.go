@@ -31,6 +31,9 @@ func (dst *Polygon) Set(src interface{}) error {
 	var p *Polygon
 	switch value := src.(type) {
 	case string:
+		if isWKT(value) {
+			return dst.UnmarshalWKT([]byte(value))
+		}
 		p, err = stringToPolygon(value)
 	case []Vec2:
 		p = &Polygon{Status: Present, P: value}
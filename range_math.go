@@ -0,0 +1,511 @@
+package pgtype
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// The range operations follow src/backend/utils/adt/rangetypes.c. A range is converted to a rangeValue, which
+// represents unbounded bounds as infinite and is canonicalized for discrete element types, and the result is converted
+// back to the typed range.
+
+var (
+	errRangeUnionNotContiguous      = errors.New("result of range union would not be contiguous")
+	errRangeDifferenceNotContiguous = errors.New("result of range difference would not be contiguous")
+)
+
+type rangeBound[T any] struct {
+	value     T
+	infinite  bool
+	inclusive bool
+	lower     bool
+}
+
+type rangeValue[T any] struct {
+	lower rangeBound[T]
+	upper rangeBound[T]
+	empty bool
+}
+
+// rangeElementOps are the operations on the element type of a range.
+type rangeElementOps[T any] struct {
+	cmp func(a, b T) int
+
+	// next returns the element after a for discrete element types and is nil for continuous element types. It returns
+	// false if a bound on a should not be canonicalized such as a date of infinity.
+	next func(a T) (T, bool, error)
+}
+
+func newRangeValue[T any](lower, upper T, lowerType, upperType BoundType, ops rangeElementOps[T]) (rangeValue[T], error) {
+	if lowerType == Empty || upperType == Empty {
+		return rangeValue[T]{empty: true}, nil
+	}
+
+	return makeRangeValue(
+		rangeBound[T]{value: lower, infinite: lowerType == Unbounded, inclusive: lowerType == Inclusive, lower: true},
+		rangeBound[T]{value: upper, infinite: upperType == Unbounded, inclusive: upperType == Inclusive},
+		ops,
+	)
+}
+
+// makeRangeValue returns the range from lower to upper. It is empty when the bounds enclose no values and it is
+// canonicalized for discrete element types. Like range_serialize the bounds are checked before they are canonicalized
+// so (4,4) is empty rather than an error. If an error occurs while canonicalizing the best possible range is returned
+// with it.
+func makeRangeValue[T any](lower, upper rangeBound[T], ops rangeElementOps[T]) (rangeValue[T], error) {
+	c := cmpRangeBoundValues(lower, upper, ops)
+	if c > 0 {
+		return rangeValue[T]{empty: true}, errors.New("range lower bound must be less than or equal to range upper bound")
+	}
+	if c == 0 && !(lower.inclusive && upper.inclusive) {
+		return rangeValue[T]{empty: true}, nil
+	}
+
+	if ops.next == nil {
+		return rangeValue[T]{lower: lower, upper: upper}, nil
+	}
+
+	var err error
+	if !lower.infinite && !lower.inclusive {
+		if v, ok, nextErr := ops.next(lower.value); nextErr != nil {
+			err = nextErr
+		} else if ok {
+			lower.value = v
+			lower.inclusive = true
+		}
+	}
+	if !upper.infinite && upper.inclusive {
+		if v, ok, nextErr := ops.next(upper.value); nextErr != nil {
+			err = nextErr
+		} else if ok {
+			upper.value = v
+			upper.inclusive = false
+		}
+	}
+
+	// Canonicalizing (4,5) gives [5,5), which is empty.
+	if cmpRangeBoundValues(lower, upper, ops) == 0 && !(lower.inclusive && upper.inclusive) {
+		return rangeValue[T]{empty: true}, err
+	}
+
+	return rangeValue[T]{lower: lower, upper: upper}, err
+}
+
+// boundTypes returns the typed range bound types of r.
+func (r rangeValue[T]) boundTypes() (BoundType, BoundType) {
+	if r.empty {
+		return Empty, Empty
+	}
+	return r.lower.boundType(), r.upper.boundType()
+}
+
+func (b rangeBound[T]) boundType() BoundType {
+	switch {
+	case b.infinite:
+		return Unbounded
+	case b.inclusive:
+		return Inclusive
+	default:
+		return Exclusive
+	}
+}
+
+// cmpRangeBounds compares two bounds taking into account whether they are lower or upper bounds and inclusive.
+func cmpRangeBounds[T any](b1, b2 rangeBound[T], ops rangeElementOps[T]) int {
+	if b1.infinite && b2.infinite {
+		if b1.lower == b2.lower {
+			return 0
+		}
+		return lowerSign(b1.lower)
+	}
+	if b1.infinite {
+		return lowerSign(b1.lower)
+	}
+	if b2.infinite {
+		return -lowerSign(b2.lower)
+	}
+
+	c := ops.cmp(b1.value, b2.value)
+	if c != 0 {
+		return c
+	}
+
+	switch {
+	case !b1.inclusive && !b2.inclusive:
+		if b1.lower == b2.lower {
+			return 0
+		}
+		return -lowerSign(b1.lower)
+	case !b1.inclusive:
+		return -lowerSign(b1.lower)
+	case !b2.inclusive:
+		return lowerSign(b2.lower)
+	}
+
+	return 0
+}
+
+// cmpRangeBoundValues compares the values of two bounds ignoring whether they are inclusive.
+func cmpRangeBoundValues[T any](b1, b2 rangeBound[T], ops rangeElementOps[T]) int {
+	if b1.infinite && b2.infinite {
+		if b1.lower == b2.lower {
+			return 0
+		}
+		return lowerSign(b1.lower)
+	}
+	if b1.infinite {
+		return lowerSign(b1.lower)
+	}
+	if b2.infinite {
+		return -lowerSign(b2.lower)
+	}
+
+	return ops.cmp(b1.value, b2.value)
+}
+
+// lowerSign returns -1 for a lower bound and 1 for an upper bound.
+func lowerSign(lower bool) int {
+	if lower {
+		return -1
+	}
+	return 1
+}
+
+func (r rangeValue[T]) containsElem(elem T, ops rangeElementOps[T]) bool {
+	if r.empty {
+		return false
+	}
+
+	if !r.lower.infinite {
+		c := ops.cmp(r.lower.value, elem)
+		if c > 0 || c == 0 && !r.lower.inclusive {
+			return false
+		}
+	}
+
+	if !r.upper.infinite {
+		c := ops.cmp(r.upper.value, elem)
+		if c < 0 || c == 0 && !r.upper.inclusive {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r rangeValue[T]) containsRange(x rangeValue[T], ops rangeElementOps[T]) bool {
+	if x.empty {
+		return true
+	}
+	if r.empty {
+		return false
+	}
+
+	return cmpRangeBounds(r.lower, x.lower, ops) <= 0 && cmpRangeBounds(r.upper, x.upper, ops) >= 0
+}
+
+func (r rangeValue[T]) overlaps(x rangeValue[T], ops rangeElementOps[T]) bool {
+	if r.empty || x.empty {
+		return false
+	}
+
+	if cmpRangeBounds(r.lower, x.lower, ops) >= 0 && cmpRangeBounds(r.lower, x.upper, ops) <= 0 {
+		return true
+	}
+	if cmpRangeBounds(x.lower, r.lower, ops) >= 0 && cmpRangeBounds(x.lower, r.upper, ops) <= 0 {
+		return true
+	}
+
+	return false
+}
+
+func (r rangeValue[T]) before(x rangeValue[T], ops rangeElementOps[T]) bool {
+	if r.empty || x.empty {
+		return false
+	}
+	return cmpRangeBounds(r.upper, x.lower, ops) < 0
+}
+
+func (r rangeValue[T]) after(x rangeValue[T], ops rangeElementOps[T]) bool {
+	if r.empty || x.empty {
+		return false
+	}
+	return cmpRangeBounds(r.lower, x.upper, ops) > 0
+}
+
+func (r rangeValue[T]) adjacent(x rangeValue[T], ops rangeElementOps[T]) bool {
+	if r.empty || x.empty {
+		return false
+	}
+	return rangeBoundsAdjacent(r.upper, x.lower, ops) || rangeBoundsAdjacent(x.upper, r.lower, ops)
+}
+
+// rangeBoundsAdjacent reports whether upper bound a and lower bound b are adjacent. That is, no values lie between
+// them and they do not overlap.
+func rangeBoundsAdjacent[T any](a, b rangeBound[T], ops rangeElementOps[T]) bool {
+	c := cmpRangeBoundValues(a, b, ops)
+	switch {
+	case c < 0:
+		if ops.next == nil {
+			return false
+		}
+		// For a discrete element type the bounds are adjacent if the range between them is empty.
+		between, err := makeRangeValue(
+			rangeBound[T]{value: a.value, inclusive: !a.inclusive, lower: true},
+			rangeBound[T]{value: b.value, inclusive: !b.inclusive},
+			ops,
+		)
+		return err == nil && between.empty
+	case c == 0:
+		return a.inclusive != b.inclusive
+	}
+
+	return false
+}
+
+func (r rangeValue[T]) union(x rangeValue[T], ops rangeElementOps[T]) (rangeValue[T], error) {
+	if r.empty {
+		return x, nil
+	}
+	if x.empty {
+		return r, nil
+	}
+
+	if !r.overlaps(x, ops) && !r.adjacent(x, ops) {
+		return rangeValue[T]{}, errRangeUnionNotContiguous
+	}
+
+	result := r
+	if cmpRangeBounds(x.lower, r.lower, ops) < 0 {
+		result.lower = x.lower
+	}
+	if cmpRangeBounds(x.upper, r.upper, ops) > 0 {
+		result.upper = x.upper
+	}
+	return result, nil
+}
+
+func (r rangeValue[T]) intersect(x rangeValue[T], ops rangeElementOps[T]) rangeValue[T] {
+	if r.empty || x.empty || !r.overlaps(x, ops) {
+		return rangeValue[T]{empty: true}
+	}
+
+	result := r
+	if cmpRangeBounds(x.lower, r.lower, ops) > 0 {
+		result.lower = x.lower
+	}
+	if cmpRangeBounds(x.upper, r.upper, ops) < 0 {
+		result.upper = x.upper
+	}
+
+	// The bounds come from the operands so they are already canonical.
+	result, _ = makeRangeValue(result.lower, result.upper, ops)
+	return result
+}
+
+func (r rangeValue[T]) difference(x rangeValue[T], ops rangeElementOps[T]) (rangeValue[T], error) {
+	if r.empty || x.empty {
+		return r, nil
+	}
+
+	cmpL1L2 := cmpRangeBounds(r.lower, x.lower, ops)
+	cmpL1U2 := cmpRangeBounds(r.lower, x.upper, ops)
+	cmpU1L2 := cmpRangeBounds(r.upper, x.lower, ops)
+	cmpU1U2 := cmpRangeBounds(r.upper, x.upper, ops)
+
+	switch {
+	case cmpL1L2 < 0 && cmpU1U2 > 0:
+		return rangeValue[T]{}, errRangeDifferenceNotContiguous
+	case cmpL1U2 > 0 || cmpU1L2 < 0:
+		return r, nil
+	case cmpL1L2 >= 0 && cmpU1U2 <= 0:
+		return rangeValue[T]{empty: true}, nil
+	case cmpL1L2 <= 0 && cmpU1L2 >= 0 && cmpU1U2 <= 0:
+		return makeRangeValue(r.lower, flipRangeBound(x.lower), ops)
+	default:
+		return makeRangeValue(flipRangeBound(x.upper), r.upper, ops)
+	}
+}
+
+func cmpInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// cmpInfinityTime orders -infinity before all times and infinity after all times.
+func cmpInfinityTime(am InfinityModifier, at time.Time, bm InfinityModifier, bt time.Time) int {
+	if am != bm {
+		return cmpInt64(int64(am), int64(bm))
+	}
+	if am != None {
+		return 0
+	}
+	switch {
+	case at.Before(bt):
+		return -1
+	case at.After(bt):
+		return 1
+	}
+	return 0
+}
+
+var int4RangeElementOps = rangeElementOps[Int4]{
+	cmp: func(a, b Int4) int { return cmpInt64(int64(a.Int), int64(b.Int)) },
+	next: func(a Int4) (Int4, bool, error) {
+		if a.Int == math.MaxInt32 {
+			return a, false, fmt.Errorf("%d + 1 is greater than maximum value for Int4", a.Int)
+		}
+		return Int4{Int: a.Int + 1, Status: Present}, true, nil
+	},
+}
+
+var int8RangeElementOps = rangeElementOps[Int8]{
+	cmp: func(a, b Int8) int { return cmpInt64(a.Int, b.Int) },
+	next: func(a Int8) (Int8, bool, error) {
+		if a.Int == math.MaxInt64 {
+			return a, false, fmt.Errorf("%d + 1 is greater than maximum value for Int8", a.Int)
+		}
+		return Int8{Int: a.Int + 1, Status: Present}, true, nil
+	},
+}
+
+var dateRangeElementOps = rangeElementOps[Date]{
+	cmp: func(a, b Date) int { return cmpInfinityTime(a.InfinityModifier, a.Time, b.InfinityModifier, b.Time) },
+	next: func(a Date) (Date, bool, error) {
+		if a.InfinityModifier != None {
+			return a, false, nil
+		}
+		return Date{Time: a.Time.AddDate(0, 0, 1), Status: Present}, true, nil
+	},
+}
+
+var timestampRangeElementOps = rangeElementOps[Timestamp]{
+	cmp: func(a, b Timestamp) int {
+		return cmpInfinityTime(a.InfinityModifier, a.Time, b.InfinityModifier, b.Time)
+	},
+}
+
+var timestamptzRangeElementOps = rangeElementOps[Timestamptz]{
+	cmp: func(a, b Timestamptz) int {
+		return cmpInfinityTime(a.InfinityModifier, a.Time, b.InfinityModifier, b.Time)
+	},
+}
+
+var numericRangeElementOps = rangeElementOps[Numeric]{
+	cmp: func(a, b Numeric) int { return a.Cmp(b) },
+}
+
+// normalizeRangeValues returns ranges sorted with empty ranges removed and overlapping or adjacent ranges merged like
+// PostgreSQL does for multirange input. ranges is modified.
+func normalizeRangeValues[T any](ranges []rangeValue[T], ops rangeElementOps[T]) []rangeValue[T] {
+	nonEmpty := ranges[:0]
+	for _, r := range ranges {
+		if !r.empty {
+			nonEmpty = append(nonEmpty, r)
+		}
+	}
+
+	sort.SliceStable(nonEmpty, func(i, j int) bool {
+		c := cmpRangeBounds(nonEmpty[i].lower, nonEmpty[j].lower, ops)
+		if c == 0 {
+			c = cmpRangeBounds(nonEmpty[i].upper, nonEmpty[j].upper, ops)
+		}
+		return c < 0
+	})
+
+	var result []rangeValue[T]
+	for _, r := range nonEmpty {
+		if n := len(result); n > 0 {
+			last := result[n-1]
+			if last.overlaps(r, ops) || last.adjacent(r, ops) {
+				result[n-1], _ = last.union(r, ops)
+				continue
+			}
+		}
+		result = append(result, r)
+	}
+
+	return result
+}
+
+// unionRangeValues returns the normalized union of a and b.
+func unionRangeValues[T any](a, b []rangeValue[T], ops rangeElementOps[T]) []rangeValue[T] {
+	ranges := make([]rangeValue[T], 0, len(a)+len(b))
+	ranges = append(ranges, a...)
+	ranges = append(ranges, b...)
+	return normalizeRangeValues(ranges, ops)
+}
+
+// intersectRangeValues returns the intersection of normalized a and b.
+func intersectRangeValues[T any](a, b []rangeValue[T], ops rangeElementOps[T]) []rangeValue[T] {
+	var result []rangeValue[T]
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if r := a[i].intersect(b[j], ops); !r.empty {
+			result = append(result, r)
+		}
+
+		// Advance past the range that ends first.
+		if cmpRangeBounds(a[i].upper, b[j].upper, ops) < 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+// differenceRangeValues returns the values of normalized a that are not in normalized b.
+func differenceRangeValues[T any](a, b []rangeValue[T], ops rangeElementOps[T]) []rangeValue[T] {
+	var result []rangeValue[T]
+	j := 0
+	for _, r := range a {
+		for ; j < len(b) && b[j].before(r, ops); j++ {
+		}
+
+		for k := j; k < len(b) && !r.empty && !b[k].after(r, ops); k++ {
+			if cmpRangeBounds(r.lower, b[k].lower, ops) < 0 && cmpRangeBounds(r.upper, b[k].upper, ops) > 0 {
+				// b[k] splits r. The part before it is final.
+				left, _ := makeRangeValue(r.lower, flipRangeBound(b[k].lower), ops)
+				if !left.empty {
+					result = append(result, left)
+				}
+				r, _ = makeRangeValue(flipRangeBound(b[k].upper), r.upper, ops)
+				continue
+			}
+			r, _ = r.difference(b[k], ops)
+		}
+
+		if !r.empty {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// gapRangeValues returns the ranges between consecutive ranges of normalized ranges.
+func gapRangeValues[T any](ranges []rangeValue[T], ops rangeElementOps[T]) []rangeValue[T] {
+	var result []rangeValue[T]
+	for i := 1; i < len(ranges); i++ {
+		gap, _ := makeRangeValue(flipRangeBound(ranges[i-1].upper), flipRangeBound(ranges[i].lower), ops)
+		if !gap.empty {
+			result = append(result, gap)
+		}
+	}
+	return result
+}
+
+// flipRangeBound turns a lower bound into the adjacent upper bound and vice versa.
+func flipRangeBound[T any](b rangeBound[T]) rangeBound[T] {
+	b.inclusive = !b.inclusive
+	b.lower = !b.lower
+	return b
+}
@@ -0,0 +1,342 @@
+package pgtype_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParseInt4range(t testing.TB, s string) pgtype.Int4range {
+	var r pgtype.Int4range
+	require.NoError(t, r.DecodeText(nil, []byte(s)))
+	return r
+}
+
+func int4rangeString(t testing.TB, r pgtype.Int4range) string {
+	buf, err := r.EncodeText(nil, nil)
+	require.NoError(t, err)
+	return string(buf)
+}
+
+// requireResult returns a function that returns v after requiring err to be nil. It allows checking the result of a
+// method that returns a value and an error inline.
+func requireResult[T any](t testing.TB) func(v T, err error) T {
+	return func(v T, err error) T {
+		t.Helper()
+		require.NoError(t, err)
+		return v
+	}
+}
+
+func TestInt4rangeCanonicalize(t *testing.T) {
+	tests := []struct {
+		src    string
+		result string
+	}{
+		{src: "[1,5)", result: "[1,5)"},
+		{src: "(1,5]", result: "[2,6)"},
+		{src: "[1,5]", result: "[1,6)"},
+		{src: "(1,2)", result: "empty"},
+		{src: "[3,3)", result: "empty"},
+		{src: "[3,3]", result: "[3,4)"},
+		{src: "(4,4)", result: "empty"},
+		{src: "(4,4]", result: "empty"},
+		{src: "[4,4)", result: "empty"},
+		{src: "(4,5)", result: "empty"},
+		{src: "(4,5]", result: "[5,6)"},
+		{src: "[4,5)", result: "[4,5)"},
+		{src: "(2147483647,2147483647]", result: "empty"},
+		{src: "(,5]", result: "(,6)"},
+		{src: "(1,)", result: "[2,)"},
+		{src: "(,)", result: "(,)"},
+		{src: "empty", result: "empty"},
+	}
+
+	for i, tt := range tests {
+		r, err := mustParseInt4range(t, tt.src).Canonicalize()
+		require.NoErrorf(t, err, "%d", i)
+		assert.Equalf(t, tt.result, int4rangeString(t, r), "%d: %s", i, tt.src)
+	}
+
+	_, err := mustParseInt4range(t, "[1,2147483647]").Canonicalize()
+	require.Error(t, err)
+	_, err = mustParseInt4range(t, "[5,1)").Canonicalize()
+	require.Error(t, err)
+}
+
+func TestInt4rangeOperationsInvalidRange(t *testing.T) {
+	valid := mustParseInt4range(t, "[1,5)")
+	for _, invalid := range []pgtype.Int4range{
+		mustParseInt4range(t, "[5,1)"),
+		mustParseInt4range(t, "[1,2147483647]"),
+	} {
+		_, err := invalid.IsEmpty()
+		assert.Error(t, err)
+		_, err = invalid.Contains(pgtype.Int4{Int: 1, Status: pgtype.Present})
+		assert.Error(t, err)
+
+		for _, fn := range []func(a, b pgtype.Int4range) (bool, error){
+			pgtype.Int4range.ContainsRange,
+			pgtype.Int4range.Overlaps,
+			pgtype.Int4range.Adjacent,
+			pgtype.Int4range.StrictlyLeftOf,
+			pgtype.Int4range.StrictlyRightOf,
+		} {
+			_, err = fn(invalid, valid)
+			assert.Error(t, err)
+			_, err = fn(valid, invalid)
+			assert.Error(t, err)
+		}
+
+		for _, fn := range []func(a, b pgtype.Int4range) (pgtype.Int4range, error){
+			pgtype.Int4range.Union,
+			pgtype.Int4range.Intersect,
+			pgtype.Int4range.Difference,
+		} {
+			_, err = fn(invalid, valid)
+			assert.Error(t, err)
+			_, err = fn(valid, invalid)
+			assert.Error(t, err)
+		}
+	}
+}
+
+func TestInt4rangeOperations(t *testing.T) {
+	must := requireResult[bool](t)
+	mustRange := requireResult[pgtype.Int4range](t)
+
+	tests := []struct {
+		a, b          string
+		containsRange bool
+		overlaps      bool
+		adjacent      bool
+		left, right   bool
+		union         string
+		intersect     string
+		difference    string
+	}{
+		{a: "[1,5)", b: "[2,3)", containsRange: true, overlaps: true, union: "[1,5)", intersect: "[2,3)", difference: "error"},
+		{a: "[1,5)", b: "[5,8)", adjacent: true, left: true, union: "[1,8)", intersect: "empty", difference: "[1,5)"},
+		{a: "[1,5]", b: "(5,8)", adjacent: true, left: true, union: "[1,8)", intersect: "empty", difference: "[1,6)"},
+		{a: "[1,5)", b: "[6,8)", left: true, union: "error", intersect: "empty", difference: "[1,5)"},
+		{a: "[6,8)", b: "[1,5)", right: true, union: "error", intersect: "empty", difference: "[6,8)"},
+		{a: "[1,5)", b: "[3,8)", overlaps: true, union: "[1,8)", intersect: "[3,5)", difference: "[1,3)"},
+		{a: "[3,8)", b: "[1,5)", overlaps: true, union: "[1,8)", intersect: "[3,5)", difference: "[5,8)"},
+		{a: "[1,5)", b: "[1,5)", containsRange: true, overlaps: true, union: "[1,5)", intersect: "[1,5)", difference: "empty"},
+		{a: "[2,3)", b: "[1,5)", overlaps: true, union: "[1,5)", intersect: "[2,3)", difference: "empty"},
+		{a: "(,5)", b: "[3,)", overlaps: true, union: "(,)", intersect: "[3,5)", difference: "(,3)"},
+		{a: "(,)", b: "[3,5)", containsRange: true, overlaps: true, union: "(,)", intersect: "[3,5)", difference: "error"},
+		{a: "(,3)", b: "[3,)", adjacent: true, left: true, union: "(,)", intersect: "empty", difference: "(,3)"},
+		{a: "[1,5)", b: "empty", containsRange: true, union: "[1,5)", intersect: "empty", difference: "[1,5)"},
+		{a: "empty", b: "[1,5)", union: "[1,5)", intersect: "empty", difference: "empty"},
+		{a: "empty", b: "empty", containsRange: true, union: "empty", intersect: "empty", difference: "empty"},
+	}
+
+	for i, tt := range tests {
+		a, b := mustParseInt4range(t, tt.a), mustParseInt4range(t, tt.b)
+		assert.Equalf(t, tt.containsRange, must(a.ContainsRange(b)), "%d: %s @> %s", i, tt.a, tt.b)
+		assert.Equalf(t, tt.overlaps, must(a.Overlaps(b)), "%d: %s && %s", i, tt.a, tt.b)
+		assert.Equalf(t, tt.overlaps, must(b.Overlaps(a)), "%d: %s && %s", i, tt.b, tt.a)
+		assert.Equalf(t, tt.adjacent, must(a.Adjacent(b)), "%d: %s -|- %s", i, tt.a, tt.b)
+		assert.Equalf(t, tt.adjacent, must(b.Adjacent(a)), "%d: %s -|- %s", i, tt.b, tt.a)
+		assert.Equalf(t, tt.left, must(a.StrictlyLeftOf(b)), "%d: %s << %s", i, tt.a, tt.b)
+		assert.Equalf(t, tt.right, must(a.StrictlyRightOf(b)), "%d: %s >> %s", i, tt.a, tt.b)
+
+		union, err := a.Union(b)
+		if tt.union == "error" {
+			assert.Errorf(t, err, "%d: %s + %s", i, tt.a, tt.b)
+		} else if assert.NoErrorf(t, err, "%d: %s + %s", i, tt.a, tt.b) {
+			assert.Equalf(t, tt.union, int4rangeString(t, union), "%d: %s + %s", i, tt.a, tt.b)
+		}
+
+		assert.Equalf(t, tt.intersect, int4rangeString(t, mustRange(a.Intersect(b))), "%d: %s * %s", i, tt.a, tt.b)
+
+		difference, err := a.Difference(b)
+		if tt.difference == "error" {
+			assert.Errorf(t, err, "%d: %s - %s", i, tt.a, tt.b)
+		} else if assert.NoErrorf(t, err, "%d: %s - %s", i, tt.a, tt.b) {
+			assert.Equalf(t, tt.difference, int4rangeString(t, difference), "%d: %s - %s", i, tt.a, tt.b)
+		}
+	}
+
+	null := pgtype.Int4range{Status: pgtype.Null}
+	r := mustParseInt4range(t, "[1,5)")
+	assert.False(t, must(r.Overlaps(null)))
+	assert.False(t, must(null.IsEmpty()))
+	union, err := r.Union(null)
+	require.NoError(t, err)
+	assert.Equal(t, pgtype.Null, union.Status)
+	assert.Equal(t, pgtype.Null, mustRange(null.Intersect(r)).Status)
+}
+
+func TestInt4rangeContains(t *testing.T) {
+	must := requireResult[bool](t)
+
+	tests := []struct {
+		r        string
+		elem     int32
+		contains bool
+	}{
+		{r: "[1,5)", elem: 1, contains: true},
+		{r: "[1,5)", elem: 4, contains: true},
+		{r: "[1,5)", elem: 5, contains: false},
+		{r: "[1,5)", elem: 0, contains: false},
+		{r: "(1,5]", elem: 1, contains: false},
+		{r: "(1,5]", elem: 5, contains: true},
+		{r: "(,5)", elem: -2147483648, contains: true},
+		{r: "[1,)", elem: 2147483647, contains: true},
+		{r: "empty", elem: 1, contains: false},
+	}
+
+	for i, tt := range tests {
+		assert.Equalf(t, tt.contains, must(mustParseInt4range(t, tt.r).Contains(pgtype.Int4{Int: tt.elem, Status: pgtype.Present})), "%d", i)
+	}
+
+	assert.False(t, must(mustParseInt4range(t, "(,)").Contains(pgtype.Int4{Status: pgtype.Null})))
+	assert.True(t, must(mustParseInt4range(t, "[3,3)").IsEmpty()))
+	assert.True(t, must(mustParseInt4range(t, "(3,4)").IsEmpty()))
+	assert.False(t, must(mustParseInt4range(t, "[3,3]").IsEmpty()))
+}
+
+func TestNumrangeOperations(t *testing.T) {
+	must := requireResult[bool](t)
+	parse := func(s string) pgtype.Numrange {
+		var r pgtype.Numrange
+		require.NoError(t, r.DecodeText(nil, []byte(s)))
+		return r
+	}
+
+	// Continuous ranges are not canonicalized.
+	r, err := parse("(1,2)").Canonicalize()
+	require.NoError(t, err)
+	assert.False(t, must(r.IsEmpty()))
+	assert.Equal(t, pgtype.Exclusive, r.LowerType)
+	assert.True(t, must(parse("[1.5,1.5)").IsEmpty()))
+	assert.False(t, must(parse("[1.5,1.5]").IsEmpty()))
+
+	assert.True(t, must(parse("[1,2)").Adjacent(parse("[2,3)"))))
+	assert.True(t, must(parse("[1,2]").Adjacent(parse("(2,3)"))))
+	assert.False(t, must(parse("[1,2]").Adjacent(parse("[2,3)"))))
+	assert.False(t, must(parse("[1,2)").Adjacent(parse("(2,3)"))))
+	assert.True(t, must(parse("[1,2)").Contains(mustDecodeNumeric(t, "1.9999"))))
+	assert.False(t, must(parse("[1,2)").Contains(mustDecodeNumeric(t, "2.0"))))
+	assert.True(t, must(parse("[1,)").Contains(mustDecodeNumeric(t, "Infinity"))))
+
+	d, err := parse("[1,3]").Difference(parse("[2,3]"))
+	require.NoError(t, err)
+	assert.Equal(t, pgtype.Exclusive, d.UpperType)
+	requireNumericEqual(t, "2", d.Upper)
+}
+
+func TestDaterangeOperations(t *testing.T) {
+	must := requireResult[bool](t)
+	parse := func(s string) pgtype.Daterange {
+		var r pgtype.Daterange
+		require.NoError(t, r.DecodeText(nil, []byte(s)))
+		return r
+	}
+	date := func(y int, m time.Month, d int) pgtype.Date {
+		return pgtype.Date{Time: time.Date(y, m, d, 0, 0, 0, 0, time.UTC), Status: pgtype.Present}
+	}
+
+	r, err := parse("[2020-01-01,2020-01-31]").Canonicalize()
+	require.NoError(t, err)
+	assert.Equal(t, pgtype.Exclusive, r.UpperType)
+	assert.Equal(t, date(2020, 2, 1), r.Upper)
+
+	// Infinite dates are not incremented.
+	r, err = parse("(2020-01-01,infinity]").Canonicalize()
+	require.NoError(t, err)
+	assert.Equal(t, pgtype.Inclusive, r.LowerType)
+	assert.Equal(t, date(2020, 1, 2), r.Lower)
+	assert.Equal(t, pgtype.Inclusive, r.UpperType)
+	assert.Equal(t, pgtype.Infinity, r.Upper.InfinityModifier)
+
+	assert.True(t, must(parse("[2020-01-01,infinity]").Contains(pgtype.Date{InfinityModifier: pgtype.Infinity, Status: pgtype.Present})))
+	assert.False(t, must(parse("[2020-01-01,)").Contains(pgtype.Date{InfinityModifier: pgtype.NegativeInfinity, Status: pgtype.Present})))
+	assert.True(t, must(parse("[2020-01-01,2020-01-10)").Adjacent(parse("[2020-01-10,2020-01-20)"))))
+	assert.True(t, must(parse("[2020-01-01,2020-01-09]").Adjacent(parse("[2020-01-10,2020-01-20)"))))
+
+	booked := parse("[2020-01-05,2020-01-08)")
+	assert.True(t, must(parse("[2020-01-07,2020-01-10)").Overlaps(booked)))
+	assert.False(t, must(parse("[2020-01-08,2020-01-10)").Overlaps(booked)))
+}
+
+func TestTstzrangeOperations(t *testing.T) {
+	must := requireResult[bool](t)
+	mustRange := requireResult[pgtype.Tstzrange](t)
+	parse := func(s string) pgtype.Tstzrange {
+		var r pgtype.Tstzrange
+		require.NoError(t, r.DecodeText(nil, []byte(s)))
+		return r
+	}
+
+	a := parse(`["2020-01-01 00:00:00+00","2020-01-02 00:00:00+00")`)
+	b := parse(`["2020-01-01 12:00:00+00",)`)
+
+	union, err := a.Union(b)
+	require.NoError(t, err)
+	assert.Equal(t, pgtype.Inclusive, union.LowerType)
+	assert.Equal(t, pgtype.Unbounded, union.UpperType)
+
+	intersect := mustRange(a.Intersect(b))
+	assert.True(t, intersect.Lower.Time.Equal(b.Lower.Time))
+	assert.True(t, intersect.Upper.Time.Equal(a.Upper.Time))
+
+	assert.True(t, must(parse(`(,)`).ContainsRange(a)))
+	assert.True(t, must(parse(`[-infinity,infinity]`).ContainsRange(a)))
+	assert.False(t, must(parse(`[-infinity,infinity]`).ContainsRange(parse(`(,)`))))
+}
+
+func TestInt4rangeOperationsMatchServer(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	must := requireResult[bool](t)
+	mustRange := requireResult[pgtype.Int4range](t)
+
+	ranges := []string{"empty", "(,)", "(,3)", "[3,)", "[1,5)", "[2,3)", "[5,8)", "[3,8)", "[6,8)", "(1,5]", "(4,4)", "(4,5]"}
+
+	for _, as := range ranges {
+		for _, bs := range ranges {
+			a, b := mustParseInt4range(t, as), mustParseInt4range(t, bs)
+
+			var containsRange, overlaps, adjacent, left, right bool
+			var intersect string
+			err := conn.QueryRow(context.Background(),
+				"select $1::int4range @> $2::int4range, $1::int4range && $2::int4range, $1::int4range -|- $2::int4range, $1::int4range << $2::int4range, $1::int4range >> $2::int4range, ($1::int4range * $2::int4range)::text",
+				as, bs,
+			).Scan(&containsRange, &overlaps, &adjacent, &left, &right, &intersect)
+			require.NoError(t, err)
+
+			assert.Equalf(t, containsRange, must(a.ContainsRange(b)), "%s @> %s", as, bs)
+			assert.Equalf(t, overlaps, must(a.Overlaps(b)), "%s && %s", as, bs)
+			assert.Equalf(t, adjacent, must(a.Adjacent(b)), "%s -|- %s", as, bs)
+			assert.Equalf(t, left, must(a.StrictlyLeftOf(b)), "%s << %s", as, bs)
+			assert.Equalf(t, right, must(a.StrictlyRightOf(b)), "%s >> %s", as, bs)
+			assert.Equalf(t, intersect, int4rangeString(t, mustRange(a.Intersect(b))), "%s * %s", as, bs)
+
+			for _, op := range []struct {
+				sql string
+				fn  func(a, b pgtype.Int4range) (pgtype.Int4range, error)
+			}{
+				{sql: "+", fn: pgtype.Int4range.Union},
+				{sql: "-", fn: pgtype.Int4range.Difference},
+			} {
+				var expected string
+				serverErr := conn.QueryRow(context.Background(), "select ($1::int4range "+op.sql+" $2::int4range)::text", as, bs).Scan(&expected)
+				actual, err := op.fn(a, b)
+				if serverErr != nil {
+					assert.Errorf(t, err, "%s %s %s", as, op.sql, bs)
+				} else if assert.NoErrorf(t, err, "%s %s %s", as, op.sql, bs) {
+					assert.Equalf(t, expected, int4rangeString(t, actual), "%s %s %s", as, op.sql, bs)
+				}
+			}
+		}
+	}
+}
@@ -4,14 +4,11 @@ import (
 	"database/sql/driver"
 	"encoding/binary"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/jackc/pgio"
 )
 
-const pgTimestampFormat = "2006-01-02 15:04:05.999999999"
-
 // Timestamp represents the PostgreSQL timestamp type. The PostgreSQL
 // timestamp does not have a time zone. This presents a problem when
 // translating to and from time.Time which requires a time zone. It is highly
@@ -23,6 +20,46 @@ type Timestamp struct {
 	InfinityModifier InfinityModifier
 }
 
+// ApplyTypeModifier rounds dst half away from zero to the fractional second precision of a timestamp(p) typmod.
+func (dst *Timestamp) ApplyTypeModifier(typmod int32) error {
+	if dst.Status != Present || dst.InfinityModifier != None || typmod < 0 {
+		return nil
+	}
+	if typmod > 6 {
+		return fmt.Errorf("timestamp(%d) precision must be between 0 and 6", typmod)
+	}
+
+	dst.Time = roundTimeToPrecision(dst.Time, typmod)
+	return nil
+}
+
+// microsecondPrecisionScales are the units in microseconds of 0 to 6 fractional second digits.
+var microsecondPrecisionScales = [7]int64{1000000, 100000, 10000, 1000, 100, 10, 1}
+
+// roundMicroseconds rounds usec half away from zero to precision fractional second digits like PostgreSQL does for
+// timestamp(p) and interval second(p).
+func roundMicroseconds(usec int64, precision int32) int64 {
+	scale := microsecondPrecisionScales[precision]
+	if usec >= 0 {
+		return (usec + scale/2) / scale * scale
+	}
+	return -((-usec + scale/2) / scale * scale)
+}
+
+// roundTimeToPrecision rounds t to precision fractional second digits. PostgreSQL rounds the microseconds since
+// 2000-01-01 half away from zero. As that is on a whole second only the fraction of the second and whether t is before
+// or after it matter.
+func roundTimeToPrecision(t time.Time, precision int32) time.Time {
+	t = t.Truncate(time.Microsecond)
+
+	usec := int64(t.Nanosecond() / 1000)
+	if t.Unix() < microsecFromUnixEpochToY2K/1000000 {
+		usec -= microsecondsPerSecond
+	}
+
+	return t.Add(time.Duration(roundMicroseconds(usec, precision)-usec) * time.Microsecond)
+}
+
 // Set converts src into a Timestamp and stores in dst. If src is a
 // time.Time in a non-UTC time zone, the time zone is discarded.
 func (dst *Timestamp) Set(src interface{}) error {
@@ -119,21 +156,15 @@ func (dst *Timestamp) DecodeText(ci *ConnInfo, src []byte) error {
 	case "-infinity":
 		*dst = Timestamp{Status: Present, InfinityModifier: -Infinity}
 	default:
-		if strings.HasSuffix(sbuf, " BC") {
-			t, err := time.Parse(pgTimestampFormat, strings.TrimRight(sbuf, " BC"))
-			t2 := time.Date(1-t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
-			if err != nil {
-				return err
-			}
-			*dst = Timestamp{Time: t2, Status: Present}
-			return nil
-		}
-		tim, err := time.Parse(pgTimestampFormat, sbuf)
+		f, err := parseDateTimeText(ci, sbuf)
 		if err != nil {
 			return err
 		}
+		if f.zone != "" {
+			return fmt.Errorf("invalid timestamp: %q", sbuf)
+		}
 
-		*dst = Timestamp{Time: tim, Status: Present}
+		*dst = Timestamp{Time: f.time(time.UTC), Status: Present}
 	}
 
 	return nil
@@ -186,7 +217,7 @@ func (src Timestamp) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
 
 	switch src.InfinityModifier {
 	case None:
-		s = src.Time.Truncate(time.Microsecond).Format(pgTimestampFormat)
+		return appendISODateTime(buf, src.Time.Truncate(time.Microsecond), true, false), nil
 	case Infinity:
 		s = "infinity"
 	case NegativeInfinity:
@@ -23,6 +23,10 @@ func TestTimestampTranscode(t *testing.T) {
 		&pgtype.Timestamp{Time: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
 		&pgtype.Timestamp{Time: time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
 		&pgtype.Timestamp{Time: time.Date(2200, 1, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+		&pgtype.Timestamp{Time: time.Date(-4713, 11, 24, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+		&pgtype.Timestamp{Time: time.Date(-43, 3, 15, 12, 30, 15, 123456000, time.UTC), Status: pgtype.Present},
+		&pgtype.Timestamp{Time: time.Date(12345, 6, 7, 8, 9, 10, 0, time.UTC), Status: pgtype.Present},
+		&pgtype.Timestamp{Time: time.Date(294276, 12, 31, 23, 59, 59, 999999000, time.UTC), Status: pgtype.Present},
 		&pgtype.Timestamp{Status: pgtype.Null},
 		&pgtype.Timestamp{Status: pgtype.Present, InfinityModifier: pgtype.Infinity},
 		&pgtype.Timestamp{Status: pgtype.Present, InfinityModifier: -pgtype.Infinity},
@@ -199,3 +203,66 @@ func TestTimestampAssignTo(t *testing.T) {
 		}
 	}
 }
+
+func TestTimestampTextFullRange(t *testing.T) {
+	tests := []struct {
+		time time.Time
+		text string
+	}{
+		{time: time.Date(-4713, 11, 24, 0, 0, 0, 0, time.UTC), text: "4714-11-24 00:00:00 BC"},
+		{time: time.Date(-43, 3, 15, 12, 30, 15, 123456000, time.UTC), text: "0044-03-15 12:30:15.123456 BC"},
+		{time: time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), text: "0001-01-01 00:00:00"},
+		{time: time.Date(1997, 12, 17, 7, 37, 16, 100000000, time.UTC), text: "1997-12-17 07:37:16.1"},
+		{time: time.Date(12345, 6, 7, 8, 9, 10, 0, time.UTC), text: "12345-06-07 08:09:10"},
+		{time: time.Date(294276, 12, 31, 23, 59, 59, 999999000, time.UTC), text: "294276-12-31 23:59:59.999999"},
+	}
+
+	for i, tt := range tests {
+		src := pgtype.Timestamp{Time: tt.time, Status: pgtype.Present}
+
+		buf, err := src.EncodeText(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.text, string(buf), "%d", i)
+
+		var dst pgtype.Timestamp
+		err = dst.DecodeText(nil, buf)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, src, dst, "%d", i)
+
+		buf, err = src.EncodeBinary(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+		dst = pgtype.Timestamp{}
+		err = dst.DecodeBinary(nil, buf)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, src, dst, "%d", i)
+	}
+}
+
+func TestTimestampApplyTypeModifier(t *testing.T) {
+	tests := []struct {
+		src    time.Time
+		typmod int32
+		result time.Time
+	}{
+		{src: time.Date(2020, 1, 2, 3, 4, 5, 123456789, time.UTC), typmod: 3, result: time.Date(2020, 1, 2, 3, 4, 5, 123000000, time.UTC)},
+		{src: time.Date(2020, 1, 2, 3, 4, 5, 123500000, time.UTC), typmod: 3, result: time.Date(2020, 1, 2, 3, 4, 5, 124000000, time.UTC)},
+		{src: time.Date(2020, 1, 2, 3, 4, 5, 999999000, time.UTC), typmod: 0, result: time.Date(2020, 1, 2, 3, 4, 6, 0, time.UTC)},
+		{src: time.Date(2020, 1, 2, 3, 4, 5, 500000000, time.UTC), typmod: 0, result: time.Date(2020, 1, 2, 3, 4, 6, 0, time.UTC)},
+		{src: time.Date(1999, 1, 2, 3, 4, 5, 500000000, time.UTC), typmod: 0, result: time.Date(1999, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{src: time.Date(1999, 1, 2, 3, 4, 5, 500001000, time.UTC), typmod: 0, result: time.Date(1999, 1, 2, 3, 4, 6, 0, time.UTC)},
+		{src: time.Date(1999, 1, 2, 3, 4, 5, 250000000, time.UTC), typmod: 1, result: time.Date(1999, 1, 2, 3, 4, 5, 200000000, time.UTC)},
+		{src: time.Date(2020, 1, 2, 3, 4, 5, 123456789, time.UTC), typmod: 6, result: time.Date(2020, 1, 2, 3, 4, 5, 123456000, time.UTC)},
+		{src: time.Date(2020, 1, 2, 3, 4, 5, 123456789, time.UTC), typmod: -1, result: time.Date(2020, 1, 2, 3, 4, 5, 123456789, time.UTC)},
+	}
+
+	for i, tt := range tests {
+		ts := pgtype.Timestamp{Time: tt.src, Status: pgtype.Present}
+		err := ts.ApplyTypeModifier(tt.typmod)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, ts.Time, "%d", i)
+	}
+
+	ts := pgtype.Timestamp{Status: pgtype.Present, InfinityModifier: pgtype.Infinity}
+	require.NoError(t, ts.ApplyTypeModifier(0))
+	require.Error(t, (&pgtype.Timestamp{Time: time.Now().UTC(), Status: pgtype.Present}).ApplyTypeModifier(7))
+}
@@ -10,9 +10,6 @@ import (
 	"github.com/jackc/pgio"
 )
 
-const pgTimestamptzHourFormat = "2006-01-02 15:04:05.999999999Z07"
-const pgTimestamptzMinuteFormat = "2006-01-02 15:04:05.999999999Z07:00"
-const pgTimestamptzSecondFormat = "2006-01-02 15:04:05.999999999Z07:00:00"
 const microsecFromUnixEpochToY2K = 946684800 * 1000000
 
 const (
@@ -26,6 +23,19 @@ type Timestamptz struct {
 	InfinityModifier InfinityModifier
 }
 
+// ApplyTypeModifier rounds dst half away from zero to the fractional second precision of a timestamptz(p) typmod.
+func (dst *Timestamptz) ApplyTypeModifier(typmod int32) error {
+	if dst.Status != Present || dst.InfinityModifier != None || typmod < 0 {
+		return nil
+	}
+	if typmod > 6 {
+		return fmt.Errorf("timestamp(%d) precision must be between 0 and 6", typmod)
+	}
+
+	dst.Time = roundTimeToPrecision(dst.Time, typmod)
+	return nil
+}
+
 func (dst *Timestamptz) Set(src interface{}) error {
 	if src == nil {
 		*dst = Timestamptz{Status: Null}
@@ -118,16 +128,11 @@ func (dst *Timestamptz) DecodeText(ci *ConnInfo, src []byte) error {
 	case "-infinity":
 		*dst = Timestamptz{Status: Present, InfinityModifier: -Infinity}
 	default:
-		var format string
-		if len(sbuf) >= 9 && (sbuf[len(sbuf)-9] == '-' || sbuf[len(sbuf)-9] == '+') {
-			format = pgTimestamptzSecondFormat
-		} else if len(sbuf) >= 6 && (sbuf[len(sbuf)-6] == '-' || sbuf[len(sbuf)-6] == '+') {
-			format = pgTimestamptzMinuteFormat
-		} else {
-			format = pgTimestamptzHourFormat
+		f, err := parseDateTimeText(ci, sbuf)
+		if err != nil {
+			return err
 		}
-
-		tim, err := time.Parse(format, sbuf)
+		tim, err := f.zonedTime(ci)
 		if err != nil {
 			return err
 		}
@@ -178,7 +183,7 @@ func (src Timestamptz) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
 
 	switch src.InfinityModifier {
 	case None:
-		s = src.Time.UTC().Truncate(time.Microsecond).Format(pgTimestamptzSecondFormat)
+		return appendISODateTime(buf, src.Time.UTC().Truncate(time.Microsecond), true, true), nil
 	case Infinity:
 		s = "infinity"
 	case NegativeInfinity:
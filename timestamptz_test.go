@@ -23,6 +23,10 @@ func TestTimestamptzTranscode(t *testing.T) {
 		&pgtype.Timestamptz{Time: time.Date(2000, 1, 1, 0, 0, 0, 0, time.Local), Status: pgtype.Present},
 		&pgtype.Timestamptz{Time: time.Date(2000, 1, 2, 0, 0, 0, 0, time.Local), Status: pgtype.Present},
 		&pgtype.Timestamptz{Time: time.Date(2200, 1, 1, 0, 0, 0, 0, time.Local), Status: pgtype.Present},
+		&pgtype.Timestamptz{Time: time.Date(-4713, 11, 24, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+		&pgtype.Timestamptz{Time: time.Date(-43, 3, 15, 12, 30, 15, 123456000, time.UTC), Status: pgtype.Present},
+		&pgtype.Timestamptz{Time: time.Date(12345, 6, 7, 8, 9, 10, 0, time.UTC), Status: pgtype.Present},
+		&pgtype.Timestamptz{Time: time.Date(294276, 12, 31, 23, 59, 59, 999999000, time.UTC), Status: pgtype.Present},
 		&pgtype.Timestamptz{Status: pgtype.Null},
 		&pgtype.Timestamptz{Status: pgtype.Present, InfinityModifier: pgtype.Infinity},
 		&pgtype.Timestamptz{Status: pgtype.Present, InfinityModifier: -pgtype.Infinity},
@@ -242,3 +246,64 @@ func TestTimestamptzUnmarshalJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestTimestamptzTextFullRange(t *testing.T) {
+	tests := []struct {
+		time time.Time
+		text string
+	}{
+		{time: time.Date(-4713, 11, 24, 0, 0, 0, 0, time.UTC), text: "4714-11-24 00:00:00Z BC"},
+		{time: time.Date(-43, 3, 15, 12, 30, 15, 123456000, time.UTC), text: "0044-03-15 12:30:15.123456Z BC"},
+		{time: time.Date(1997, 12, 17, 7, 37, 16, 0, time.FixedZone("", -8*3600)), text: "1997-12-17 15:37:16Z"},
+		{time: time.Date(12345, 6, 7, 8, 9, 10, 0, time.UTC), text: "12345-06-07 08:09:10Z"},
+		{time: time.Date(294276, 12, 31, 23, 59, 59, 999999000, time.UTC), text: "294276-12-31 23:59:59.999999Z"},
+	}
+
+	for i, tt := range tests {
+		src := pgtype.Timestamptz{Time: tt.time, Status: pgtype.Present}
+
+		buf, err := src.EncodeText(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.text, string(buf), "%d", i)
+
+		var dst pgtype.Timestamptz
+		err = dst.DecodeText(nil, buf)
+		require.NoErrorf(t, err, "%d", i)
+		require.Truef(t, tt.time.Equal(dst.Time), "%d: %v", i, dst.Time)
+
+		buf, err = src.EncodeBinary(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+		dst = pgtype.Timestamptz{}
+		err = dst.DecodeBinary(nil, buf)
+		require.NoErrorf(t, err, "%d", i)
+		require.Truef(t, tt.time.Equal(dst.Time), "%d: %v", i, dst.Time)
+	}
+
+	decodeTests := []struct {
+		text string
+		time time.Time
+	}{
+		{text: "0044-03-15 12:30:15+00 BC", time: time.Date(-43, 3, 15, 12, 30, 15, 0, time.UTC)},
+		{text: "0044-03-15 12:30:15-00:01:15 BC", time: time.Date(-43, 3, 15, 12, 31, 30, 0, time.UTC)},
+		{text: "10000-01-01 00:00:00+05:30", time: time.Date(9999, 12, 31, 18, 30, 0, 0, time.UTC)},
+	}
+
+	for i, tt := range decodeTests {
+		var dst pgtype.Timestamptz
+		err := dst.DecodeText(nil, []byte(tt.text))
+		require.NoErrorf(t, err, "%d", i)
+		require.Truef(t, tt.time.Equal(dst.Time), "%d: %v", i, dst.Time)
+	}
+}
+
+func TestTimestamptzApplyTypeModifier(t *testing.T) {
+	loc := time.FixedZone("", -5*3600)
+
+	tstz := pgtype.Timestamptz{Time: time.Date(2020, 1, 2, 3, 4, 5, 987654321, loc), Status: pgtype.Present}
+	require.NoError(t, tstz.ApplyTypeModifier(2))
+	require.Equal(t, time.Date(2020, 1, 2, 3, 4, 5, 990000000, loc), tstz.Time)
+
+	tstz = pgtype.Timestamptz{Time: time.Date(1960, 1, 2, 3, 4, 5, 500000000, loc), Status: pgtype.Present}
+	require.NoError(t, tstz.ApplyTypeModifier(0))
+	require.Equal(t, time.Date(1960, 1, 2, 3, 4, 5, 0, loc), tstz.Time)
+}
@@ -265,3 +265,182 @@ func (dst *Tsrange) Scan(src interface{}) error {
 func (src Tsrange) Value() (driver.Value, error) {
 	return EncodeValueText(src)
 }
+
+func (src Tsrange) rangeValue() (rangeValue[Timestamp], error) {
+	return newRangeValue(src.Lower, src.Upper, src.LowerType, src.UpperType, timestampRangeElementOps)
+}
+
+func newTsrangeFromRangeValue(r rangeValue[Timestamp]) Tsrange {
+	dst := Tsrange{Status: Present}
+	dst.LowerType, dst.UpperType = r.boundTypes()
+	if dst.LowerType == Inclusive || dst.LowerType == Exclusive {
+		dst.Lower = r.lower.value
+	}
+	if dst.UpperType == Inclusive || dst.UpperType == Exclusive {
+		dst.Upper = r.upper.value
+	}
+	return dst
+}
+
+// Canonicalize returns src in the canonical form PostgreSQL uses. A range that contains no values is empty and a
+// range of a discrete element type has an inclusive lower bound and an exclusive upper bound.
+func (src Tsrange) Canonicalize() (Tsrange, error) {
+	if src.Status != Present {
+		return Tsrange{Status: src.Status}, nil
+	}
+
+	r, err := src.rangeValue()
+	if err != nil {
+		return Tsrange{}, err
+	}
+	return newTsrangeFromRangeValue(r), nil
+}
+
+// rangeValuePair returns the range values of src and x.
+func (src Tsrange) rangeValuePair(x Tsrange) (rangeValue[Timestamp], rangeValue[Timestamp], error) {
+	r, err := src.rangeValue()
+	if err != nil {
+		return r, r, err
+	}
+	xr, err := x.rangeValue()
+	return r, xr, err
+}
+
+// IsEmpty reports whether src contains no values. It is an error if src is not a valid range.
+func (src Tsrange) IsEmpty() (bool, error) {
+	if src.Status != Present {
+		return false, nil
+	}
+	r, err := src.rangeValue()
+	if err != nil {
+		return false, err
+	}
+	return r.empty, nil
+}
+
+// Contains reports whether src contains elem like the PostgreSQL @> operator. It is an error if src is not a valid
+// range.
+func (src Tsrange) Contains(elem Timestamp) (bool, error) {
+	if src.Status != Present || elem.Status != Present {
+		return false, nil
+	}
+	r, err := src.rangeValue()
+	if err != nil {
+		return false, err
+	}
+	return r.containsElem(elem, timestampRangeElementOps), nil
+}
+
+// ContainsRange reports whether src contains x like the PostgreSQL @> operator. It is an error if either range is
+// not a valid range.
+func (src Tsrange) ContainsRange(x Tsrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.containsRange(xr, timestampRangeElementOps), nil
+}
+
+// Overlaps reports whether src and x have values in common like the PostgreSQL && operator. It is an error if either
+// range is not a valid range.
+func (src Tsrange) Overlaps(x Tsrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.overlaps(xr, timestampRangeElementOps), nil
+}
+
+// Adjacent reports whether src and x are adjacent like the PostgreSQL -|- operator. It is an error if either range is
+// not a valid range.
+func (src Tsrange) Adjacent(x Tsrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.adjacent(xr, timestampRangeElementOps), nil
+}
+
+// StrictlyLeftOf reports whether all values of src are less than all values of x like the PostgreSQL << operator. It
+// is an error if either range is not a valid range.
+func (src Tsrange) StrictlyLeftOf(x Tsrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.before(xr, timestampRangeElementOps), nil
+}
+
+// StrictlyRightOf reports whether all values of src are greater than all values of x like the PostgreSQL >>
+// operator. It is an error if either range is not a valid range.
+func (src Tsrange) StrictlyRightOf(x Tsrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.after(xr, timestampRangeElementOps), nil
+}
+
+// Union returns the union of src and x like the PostgreSQL + operator. It is an error if the ranges neither overlap
+// nor are adjacent. If either range is not Present the result is Null.
+func (src Tsrange) Union(x Tsrange) (Tsrange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Tsrange{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Tsrange{}, err
+	}
+
+	result, err := r.union(xr, timestampRangeElementOps)
+	if err != nil {
+		return Tsrange{}, err
+	}
+	return newTsrangeFromRangeValue(result), nil
+}
+
+// Intersect returns the intersection of src and x like the PostgreSQL * operator. If either range is not Present the
+// result is Null. It is an error if either range is not a valid range.
+func (src Tsrange) Intersect(x Tsrange) (Tsrange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Tsrange{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Tsrange{}, err
+	}
+	return newTsrangeFromRangeValue(r.intersect(xr, timestampRangeElementOps)), nil
+}
+
+// Difference returns the values of src that are not in x like the PostgreSQL - operator. It is an error if x is
+// strictly inside src so the result would be two ranges. If either range is not Present the result is Null.
+func (src Tsrange) Difference(x Tsrange) (Tsrange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Tsrange{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Tsrange{}, err
+	}
+
+	result, err := r.difference(xr, timestampRangeElementOps)
+	if err != nil {
+		return Tsrange{}, err
+	}
+	return newTsrangeFromRangeValue(result), nil
+}
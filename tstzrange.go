@@ -265,3 +265,182 @@ func (dst *Tstzrange) Scan(src interface{}) error {
 func (src Tstzrange) Value() (driver.Value, error) {
 	return EncodeValueText(src)
 }
+
+func (src Tstzrange) rangeValue() (rangeValue[Timestamptz], error) {
+	return newRangeValue(src.Lower, src.Upper, src.LowerType, src.UpperType, timestamptzRangeElementOps)
+}
+
+func newTstzrangeFromRangeValue(r rangeValue[Timestamptz]) Tstzrange {
+	dst := Tstzrange{Status: Present}
+	dst.LowerType, dst.UpperType = r.boundTypes()
+	if dst.LowerType == Inclusive || dst.LowerType == Exclusive {
+		dst.Lower = r.lower.value
+	}
+	if dst.UpperType == Inclusive || dst.UpperType == Exclusive {
+		dst.Upper = r.upper.value
+	}
+	return dst
+}
+
+// Canonicalize returns src in the canonical form PostgreSQL uses. A range that contains no values is empty and a
+// range of a discrete element type has an inclusive lower bound and an exclusive upper bound.
+func (src Tstzrange) Canonicalize() (Tstzrange, error) {
+	if src.Status != Present {
+		return Tstzrange{Status: src.Status}, nil
+	}
+
+	r, err := src.rangeValue()
+	if err != nil {
+		return Tstzrange{}, err
+	}
+	return newTstzrangeFromRangeValue(r), nil
+}
+
+// rangeValuePair returns the range values of src and x.
+func (src Tstzrange) rangeValuePair(x Tstzrange) (rangeValue[Timestamptz], rangeValue[Timestamptz], error) {
+	r, err := src.rangeValue()
+	if err != nil {
+		return r, r, err
+	}
+	xr, err := x.rangeValue()
+	return r, xr, err
+}
+
+// IsEmpty reports whether src contains no values. It is an error if src is not a valid range.
+func (src Tstzrange) IsEmpty() (bool, error) {
+	if src.Status != Present {
+		return false, nil
+	}
+	r, err := src.rangeValue()
+	if err != nil {
+		return false, err
+	}
+	return r.empty, nil
+}
+
+// Contains reports whether src contains elem like the PostgreSQL @> operator. It is an error if src is not a valid
+// range.
+func (src Tstzrange) Contains(elem Timestamptz) (bool, error) {
+	if src.Status != Present || elem.Status != Present {
+		return false, nil
+	}
+	r, err := src.rangeValue()
+	if err != nil {
+		return false, err
+	}
+	return r.containsElem(elem, timestamptzRangeElementOps), nil
+}
+
+// ContainsRange reports whether src contains x like the PostgreSQL @> operator. It is an error if either range is
+// not a valid range.
+func (src Tstzrange) ContainsRange(x Tstzrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.containsRange(xr, timestamptzRangeElementOps), nil
+}
+
+// Overlaps reports whether src and x have values in common like the PostgreSQL && operator. It is an error if either
+// range is not a valid range.
+func (src Tstzrange) Overlaps(x Tstzrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.overlaps(xr, timestamptzRangeElementOps), nil
+}
+
+// Adjacent reports whether src and x are adjacent like the PostgreSQL -|- operator. It is an error if either range is
+// not a valid range.
+func (src Tstzrange) Adjacent(x Tstzrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.adjacent(xr, timestamptzRangeElementOps), nil
+}
+
+// StrictlyLeftOf reports whether all values of src are less than all values of x like the PostgreSQL << operator. It
+// is an error if either range is not a valid range.
+func (src Tstzrange) StrictlyLeftOf(x Tstzrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.before(xr, timestamptzRangeElementOps), nil
+}
+
+// StrictlyRightOf reports whether all values of src are greater than all values of x like the PostgreSQL >>
+// operator. It is an error if either range is not a valid range.
+func (src Tstzrange) StrictlyRightOf(x Tstzrange) (bool, error) {
+	if src.Status != Present || x.Status != Present {
+		return false, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return false, err
+	}
+	return r.after(xr, timestamptzRangeElementOps), nil
+}
+
+// Union returns the union of src and x like the PostgreSQL + operator. It is an error if the ranges neither overlap
+// nor are adjacent. If either range is not Present the result is Null.
+func (src Tstzrange) Union(x Tstzrange) (Tstzrange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Tstzrange{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Tstzrange{}, err
+	}
+
+	result, err := r.union(xr, timestamptzRangeElementOps)
+	if err != nil {
+		return Tstzrange{}, err
+	}
+	return newTstzrangeFromRangeValue(result), nil
+}
+
+// Intersect returns the intersection of src and x like the PostgreSQL * operator. If either range is not Present the
+// result is Null. It is an error if either range is not a valid range.
+func (src Tstzrange) Intersect(x Tstzrange) (Tstzrange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Tstzrange{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Tstzrange{}, err
+	}
+	return newTstzrangeFromRangeValue(r.intersect(xr, timestamptzRangeElementOps)), nil
+}
+
+// Difference returns the values of src that are not in x like the PostgreSQL - operator. It is an error if x is
+// strictly inside src so the result would be two ranges. If either range is not Present the result is Null.
+func (src Tstzrange) Difference(x Tstzrange) (Tstzrange, error) {
+	if src.Status != Present || x.Status != Present {
+		return Tstzrange{Status: Null}, nil
+	}
+	r, xr, err := src.rangeValuePair(x)
+	if err != nil {
+		return Tstzrange{}, err
+	}
+
+	result, err := r.difference(xr, timestamptzRangeElementOps)
+	if err != nil {
+		return Tstzrange{}, err
+	}
+	return newTstzrangeFromRangeValue(result), nil
+}
@@ -4,6 +4,8 @@ import (
 	"database/sql/driver"
 	"encoding/binary"
 	"fmt"
+	"math/big"
+	"math/bits"
 
 	"github.com/jackc/pgio"
 )
@@ -14,8 +16,90 @@ type Varbit struct {
 	Status Status
 }
 
+// Set converts src to a Varbit. It accepts []bool with one element per bit, a string of 0 and 1 characters, a uint64
+// as 64 bits, and a non-negative big.Int or *big.Int as its binary representation without leading zeros.
 func (dst *Varbit) Set(src interface{}) error {
-	return fmt.Errorf("cannot convert %v to Varbit", src)
+	if src == nil {
+		*dst = Varbit{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case Varbit:
+		*dst = value
+	case Bit:
+		*dst = Varbit(value)
+	case []bool:
+		if value == nil {
+			*dst = Varbit{Status: Null}
+			return nil
+		}
+		buf := make([]byte, (len(value)+7)/8)
+		for i, b := range value {
+			if b {
+				buf[i/8] |= 128 >> uint(i%8)
+			}
+		}
+		*dst = Varbit{Bytes: buf, Len: int32(len(value)), Status: Present}
+	case string:
+		for i := 0; i < len(value); i++ {
+			if value[i] != '0' && value[i] != '1' {
+				return fmt.Errorf("%q is not a valid binary digit", value[i:i+1])
+			}
+		}
+		return dst.DecodeText(nil, []byte(value))
+	case *string:
+		if value == nil {
+			*dst = Varbit{Status: Null}
+		} else {
+			return dst.Set(*value)
+		}
+	case uint64:
+		buf := make([]byte, 8)
+		binary.BigEndian.PutUint64(buf, value)
+		*dst = Varbit{Bytes: buf, Len: 64, Status: Present}
+	case *uint64:
+		if value == nil {
+			*dst = Varbit{Status: Null}
+		} else {
+			return dst.Set(*value)
+		}
+	case big.Int:
+		return dst.Set(&value)
+	case *big.Int:
+		if value == nil {
+			*dst = Varbit{Status: Null}
+			return nil
+		}
+		if value.Sign() < 0 {
+			return fmt.Errorf("cannot convert negative %v to Varbit", value)
+		}
+		bitLen := value.BitLen()
+		if bitLen == 0 {
+			bitLen = 1
+		}
+		// Shift the value so its first bit is the high bit of the first byte.
+		buf := new(big.Int).Lsh(value, uint((8-bitLen%8)%8)).Bytes()
+		bytes := make([]byte, (bitLen+7)/8)
+		copy(bytes[len(bytes)-len(buf):], buf)
+		*dst = Varbit{Bytes: bytes, Len: int32(bitLen), Status: Present}
+	default:
+		return fmt.Errorf("cannot convert %v to Varbit", src)
+	}
+
+	return nil
+}
+
+// ApplyTypeModifier returns an error if dst has more bits than the length of a varbit(n) typmod.
+func (dst *Varbit) ApplyTypeModifier(typmod int32) error {
+	if dst.Status != Present || typmod < 0 {
+		return nil
+	}
+
+	if dst.Len > typmod {
+		return fmt.Errorf("bit string too long for type bit varying(%d)", typmod)
+	}
+	return nil
 }
 
 func (dst Varbit) Get() interface{} {
@@ -29,8 +113,151 @@ func (dst Varbit) Get() interface{} {
 	}
 }
 
+// AssignTo assigns src to *[]bool, *string, *uint64 or *big.Int. The bits are read as an unsigned integer with the
+// first bit as the most significant one like PostgreSQL's bit to integer casts.
 func (src *Varbit) AssignTo(dst interface{}) error {
-	return fmt.Errorf("cannot assign %v to %T", src, dst)
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *[]bool:
+			bools := make([]bool, src.Len)
+			for i := range bools {
+				bools[i] = src.BitAt(i)
+			}
+			*v = bools
+			return nil
+		case *string:
+			buf, err := src.EncodeText(nil, nil)
+			if err != nil {
+				return err
+			}
+			*v = string(buf)
+			return nil
+		case *uint64:
+			if src.Len > 64 {
+				return fmt.Errorf("%d bits is too many for %T", src.Len, *v)
+			}
+			var n uint64
+			for i := 0; i < int(src.Len); i++ {
+				n <<= 1
+				if src.BitAt(i) {
+					n |= 1
+				}
+			}
+			*v = n
+			return nil
+		case *big.Int:
+			v.SetBytes(src.Bytes[:(src.Len+7)/8])
+			v.Rsh(v, uint((8-src.Len%8)%8))
+			return nil
+		default:
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+// BitAt returns bit i of src where bit 0 is the first, leftmost bit. It panics if i is out of range.
+func (src Varbit) BitAt(i int) bool {
+	if i < 0 || i >= int(src.Len) {
+		panic(fmt.Sprintf("bit index %d out of range [0, %d)", i, src.Len))
+	}
+	return src.Bytes[i/8]&(128>>uint(i%8)) != 0
+}
+
+// SetBitAt sets bit i of dst where bit 0 is the first, leftmost bit. It panics if i is out of range.
+func (dst *Varbit) SetBitAt(i int, value bool) {
+	if i < 0 || i >= int(dst.Len) {
+		panic(fmt.Sprintf("bit index %d out of range [0, %d)", i, dst.Len))
+	}
+	if value {
+		dst.Bytes[i/8] |= 128 >> uint(i%8)
+	} else {
+		dst.Bytes[i/8] &^= 128 >> uint(i%8)
+	}
+}
+
+// And returns the bitwise AND of src and x. Like PostgreSQL both must have the same length.
+func (src Varbit) And(x Varbit) (Varbit, error) {
+	return src.bitwise(x, "AND", func(a, b byte) byte { return a & b })
+}
+
+// Or returns the bitwise OR of src and x. Like PostgreSQL both must have the same length.
+func (src Varbit) Or(x Varbit) (Varbit, error) {
+	return src.bitwise(x, "OR", func(a, b byte) byte { return a | b })
+}
+
+// Xor returns the bitwise XOR of src and x. Like PostgreSQL both must have the same length.
+func (src Varbit) Xor(x Varbit) (Varbit, error) {
+	return src.bitwise(x, "XOR", func(a, b byte) byte { return a ^ b })
+}
+
+func (src Varbit) bitwise(x Varbit, name string, op func(a, b byte) byte) (Varbit, error) {
+	if src.Status != Present || x.Status != Present {
+		return Varbit{Status: Null}, nil
+	}
+	if src.Len != x.Len {
+		return Varbit{}, fmt.Errorf("cannot %s bit strings of different sizes", name)
+	}
+
+	buf := make([]byte, (src.Len+7)/8)
+	for i := range buf {
+		buf[i] = op(src.Bytes[i], x.Bytes[i])
+	}
+	return Varbit{Bytes: buf, Len: src.Len, Status: Present}, nil
+}
+
+// Not returns the bitwise complement of src.
+func (src Varbit) Not() Varbit {
+	if src.Status != Present {
+		return Varbit{Status: Null}
+	}
+
+	buf := make([]byte, (src.Len+7)/8)
+	for i := range buf {
+		buf[i] = ^src.Bytes[i]
+	}
+	if rem := src.Len % 8; rem != 0 {
+		buf[len(buf)-1] &= 0xff << uint(8-rem)
+	}
+	return Varbit{Bytes: buf, Len: src.Len, Status: Present}
+}
+
+// ShiftLeft returns src shifted n bits to the left keeping its length. Bits shifted in are zero. A negative n shifts to
+// the right.
+func (src Varbit) ShiftLeft(n int) Varbit {
+	if src.Status != Present {
+		return Varbit{Status: Null}
+	}
+
+	dst := Varbit{Bytes: make([]byte, (src.Len+7)/8), Len: src.Len, Status: Present}
+	for i := 0; i < int(src.Len); i++ {
+		if j := i + n; j >= 0 && j < int(src.Len) && src.BitAt(j) {
+			dst.Bytes[i/8] |= 128 >> uint(i%8)
+		}
+	}
+	return dst
+}
+
+// ShiftRight returns src shifted n bits to the right keeping its length. Bits shifted in are zero. A negative n shifts
+// to the left.
+func (src Varbit) ShiftRight(n int) Varbit {
+	return src.ShiftLeft(-n)
+}
+
+// OnesCount returns the number of set bits in src.
+func (src Varbit) OnesCount() int {
+	count := 0
+	for i := 0; i < int(src.Len+7)/8; i++ {
+		count += bits.OnesCount8(src.Bytes[i])
+	}
+	return count
 }
 
 func (dst *Varbit) DecodeText(ci *ConnInfo, src []byte) error {
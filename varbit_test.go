@@ -1,10 +1,13 @@
 package pgtype_test
 
 import (
+	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgtype/testutil"
+	"github.com/stretchr/testify/require"
 )
 
 func TestVarbitTranscode(t *testing.T) {
@@ -24,3 +27,155 @@ func TestVarbitNormalize(t *testing.T) {
 		},
 	})
 }
+
+func TestVarbitApplyTypeModifier(t *testing.T) {
+	v := pgtype.Varbit{Bytes: []byte{255}, Len: 8, Status: pgtype.Present}
+	require.NoError(t, v.ApplyTypeModifier(8))
+	require.NoError(t, v.ApplyTypeModifier(9))
+	require.NoError(t, v.ApplyTypeModifier(-1))
+	require.Error(t, v.ApplyTypeModifier(7))
+}
+
+func mustSetVarbit(t testing.TB, s string) pgtype.Varbit {
+	var v pgtype.Varbit
+	require.NoError(t, v.Set(s))
+	return v
+}
+
+func TestVarbitSet(t *testing.T) {
+	successfulTests := []struct {
+		source interface{}
+		result pgtype.Varbit
+	}{
+		{source: "", result: pgtype.Varbit{Bytes: []byte{}, Len: 0, Status: pgtype.Present}},
+		{source: "101", result: pgtype.Varbit{Bytes: []byte{160}, Len: 3, Status: pgtype.Present}},
+		{source: []bool{true, false, true, true, false, false, false, false, true}, result: pgtype.Varbit{Bytes: []byte{176, 128}, Len: 9, Status: pgtype.Present}},
+		{source: uint64(0x8000000000000001), result: pgtype.Varbit{Bytes: []byte{128, 0, 0, 0, 0, 0, 0, 1}, Len: 64, Status: pgtype.Present}},
+		{source: big.NewInt(0), result: pgtype.Varbit{Bytes: []byte{0}, Len: 1, Status: pgtype.Present}},
+		{source: big.NewInt(5), result: pgtype.Varbit{Bytes: []byte{160}, Len: 3, Status: pgtype.Present}},
+		{source: big.NewInt(0x1ff), result: pgtype.Varbit{Bytes: []byte{255, 128}, Len: 9, Status: pgtype.Present}},
+		{source: *big.NewInt(5), result: pgtype.Varbit{Bytes: []byte{160}, Len: 3, Status: pgtype.Present}},
+		{source: pgtype.Bit{Bytes: []byte{128}, Len: 1, Status: pgtype.Present}, result: pgtype.Varbit{Bytes: []byte{128}, Len: 1, Status: pgtype.Present}},
+		{source: nil, result: pgtype.Varbit{Status: pgtype.Null}},
+		{source: ([]bool)(nil), result: pgtype.Varbit{Status: pgtype.Null}},
+		{source: (*big.Int)(nil), result: pgtype.Varbit{Status: pgtype.Null}},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgtype.Varbit
+		err := r.Set(tt.source)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, r, "%d", i)
+	}
+
+	for i, src := range []interface{}{"102", big.NewInt(-1), *big.NewInt(-1), 42} {
+		var r pgtype.Varbit
+		require.Errorf(t, r.Set(src), "%d", i)
+	}
+}
+
+func TestVarbitAssignTo(t *testing.T) {
+	src := mustSetVarbit(t, "101100001")
+
+	var bools []bool
+	require.NoError(t, src.AssignTo(&bools))
+	require.Equal(t, []bool{true, false, true, true, false, false, false, false, true}, bools)
+
+	var s string
+	require.NoError(t, src.AssignTo(&s))
+	require.Equal(t, "101100001", s)
+
+	var n uint64
+	require.NoError(t, src.AssignTo(&n))
+	require.Equal(t, uint64(0x161), n)
+
+	var pn *uint64
+	require.NoError(t, src.AssignTo(&pn))
+	require.Equal(t, uint64(0x161), *pn)
+
+	bi := new(big.Int)
+	require.NoError(t, src.AssignTo(bi))
+	require.Equal(t, "353", bi.String())
+
+	long := mustSetVarbit(t, "1"+strings.Repeat("0", 64))
+	var tooSmall uint64
+	require.Error(t, long.AssignTo(&tooSmall))
+	longInt := new(big.Int)
+	require.NoError(t, long.AssignTo(longInt))
+	require.Equal(t, new(big.Int).Lsh(big.NewInt(1), 64), longInt)
+
+	null := pgtype.Varbit{Status: pgtype.Null}
+	var nullPtr *uint64
+	require.NoError(t, null.AssignTo(&nullPtr))
+	require.Nil(t, nullPtr)
+	require.Error(t, null.AssignTo(&tooSmall))
+
+	// Round trip through each Go type.
+	for i, v := range []interface{}{bools, s, n, bi} {
+		var r pgtype.Varbit
+		require.NoErrorf(t, r.Set(v), "%d", i)
+		var rs string
+		require.NoErrorf(t, r.AssignTo(&rs), "%d", i)
+		require.Equalf(t, strings.TrimLeft(s, "0"), strings.TrimLeft(rs, "0"), "%d", i)
+	}
+}
+
+func TestVarbitBitAt(t *testing.T) {
+	v := mustSetVarbit(t, "100000001")
+	require.True(t, v.BitAt(0))
+	require.False(t, v.BitAt(1))
+	require.True(t, v.BitAt(8))
+	require.Panics(t, func() { v.BitAt(9) })
+	require.Panics(t, func() { v.BitAt(-1) })
+
+	v.SetBitAt(0, false)
+	v.SetBitAt(4, true)
+	require.Equal(t, mustSetVarbit(t, "000010001"), v)
+	require.Panics(t, func() { v.SetBitAt(9, true) })
+}
+
+func TestVarbitBitwise(t *testing.T) {
+	a := mustSetVarbit(t, "110011001")
+	b := mustSetVarbit(t, "101010100")
+
+	r, err := a.And(b)
+	require.NoError(t, err)
+	require.Equal(t, mustSetVarbit(t, "100010000"), r)
+
+	r, err = a.Or(b)
+	require.NoError(t, err)
+	require.Equal(t, mustSetVarbit(t, "111011101"), r)
+
+	r, err = a.Xor(b)
+	require.NoError(t, err)
+	require.Equal(t, mustSetVarbit(t, "011001101"), r)
+
+	require.Equal(t, mustSetVarbit(t, "001100110"), a.Not())
+	require.Equal(t, 0, mustSetVarbit(t, "111111111").Not().OnesCount())
+
+	_, err = a.And(mustSetVarbit(t, "1"))
+	require.Error(t, err)
+
+	r, err = a.Or(pgtype.Varbit{Status: pgtype.Null})
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Null, r.Status)
+
+	// Operands are not modified.
+	require.Equal(t, mustSetVarbit(t, "110011001"), a)
+}
+
+func TestVarbitShift(t *testing.T) {
+	v := mustSetVarbit(t, "110011001")
+	require.Equal(t, mustSetVarbit(t, "001100100"), v.ShiftLeft(2))
+	require.Equal(t, mustSetVarbit(t, "001100110"), v.ShiftRight(2))
+	require.Equal(t, v.ShiftRight(3), v.ShiftLeft(-3))
+	require.Equal(t, mustSetVarbit(t, "000000000"), v.ShiftLeft(9))
+	require.Equal(t, mustSetVarbit(t, "000000000"), v.ShiftRight(100))
+	require.Equal(t, v, v.ShiftLeft(0))
+}
+
+func TestVarbitOnesCount(t *testing.T) {
+	require.Equal(t, 5, mustSetVarbit(t, "110011001").OnesCount())
+	require.Equal(t, 0, mustSetVarbit(t, "").OnesCount())
+	require.Equal(t, 64, pgtype.Varbit{Bytes: []byte{255, 255, 255, 255, 255, 255, 255, 255}, Len: 64, Status: pgtype.Present}.OnesCount())
+}
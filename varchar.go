@@ -2,6 +2,9 @@ package pgtype
 
 import (
 	"database/sql/driver"
+	"fmt"
+	"strings"
+	"unicode/utf8"
 )
 
 type Varchar Text
@@ -13,6 +16,40 @@ func (dst *Varchar) Set(src interface{}) error {
 	return (*Text)(dst).Set(src)
 }
 
+// ApplyTypeModifier limits dst to the length of a varchar(n) typmod. Like PostgreSQL it is an error if dst is longer
+// unless the excess characters are all spaces, which are discarded.
+func (dst *Varchar) ApplyTypeModifier(typmod int32) error {
+	if dst.Status != Present || typmod < varlenaHeaderSize {
+		return nil
+	}
+
+	s, err := limitCharacterLength(dst.String, int(typmod-varlenaHeaderSize), "character varying")
+	if err != nil {
+		return err
+	}
+	dst.String = s
+	return nil
+}
+
+// limitCharacterLength returns s limited to n characters. Excess characters are only discarded if they are all spaces.
+func limitCharacterLength(s string, n int, typeName string) (string, error) {
+	if utf8.RuneCountInString(s) <= n {
+		return s, nil
+	}
+
+	i := 0
+	for count := 0; count < n; count++ {
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+
+	if strings.TrimLeft(s[i:], " ") != "" {
+		return "", fmt.Errorf("value too long for type %s(%d)", typeName, n)
+	}
+
+	return s[:i], nil
+}
+
 func (dst Varchar) Get() interface{} {
 	return (Text)(dst).Get()
 }
@@ -0,0 +1,30 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVarcharApplyTypeModifier(t *testing.T) {
+	tests := []struct {
+		src    string
+		typmod int32
+		result string
+	}{
+		{src: "ab", typmod: 5 + 4, result: "ab"},
+		{src: "héllo  ", typmod: 5 + 4, result: "héllo"},
+		{src: "hello", typmod: -1, result: "hello"},
+	}
+
+	for i, tt := range tests {
+		v := pgtype.Varchar{String: tt.src, Status: pgtype.Present}
+		err := v.ApplyTypeModifier(tt.typmod)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, v.String, "%d", i)
+	}
+
+	v := pgtype.Varchar{String: "hello world", Status: pgtype.Present}
+	require.EqualError(t, v.ApplyTypeModifier(5+4), "value too long for type character varying(5)")
+}
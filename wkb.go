@@ -0,0 +1,403 @@
+package pgtype
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// WKB geometry type codes.
+const (
+	wkbPoint      = 1
+	wkbLineString = 2
+	wkbPolygon    = 3
+)
+
+// WKB byte order markers.
+const (
+	wkbXDR = 0 // big endian
+	wkbNDR = 1 // little endian
+)
+
+// MarshalWKB returns src as a WKB Point in byte order, which must be binary.BigEndian or binary.LittleEndian.
+func (src Point) MarshalWKB(byteOrder binary.ByteOrder) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	w, err := newWKBWriter(byteOrder, wkbPoint)
+	if err != nil {
+		return nil, err
+	}
+	w.position(src.P)
+	return w.buf, nil
+}
+
+// UnmarshalWKB decodes a WKB Point in either byte order into dst.
+func (dst *Point) UnmarshalWKB(src []byte) error {
+	if src == nil {
+		*dst = Point{Status: Null}
+		return nil
+	}
+
+	g, err := parseWKB(src)
+	if err != nil {
+		return err
+	}
+	if g.typ != "POINT" || g.empty {
+		return errWKBType(g, dst)
+	}
+
+	*dst = Point{P: g.rings[0][0], Status: Present}
+	return nil
+}
+
+// MarshalWKB returns src as a WKB LineString in byte order, which must be binary.BigEndian or binary.LittleEndian.
+func (src Lseg) MarshalWKB(byteOrder binary.ByteOrder) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	w, err := newWKBWriter(byteOrder, wkbLineString)
+	if err != nil {
+		return nil, err
+	}
+	w.points(src.P[:], false)
+	return w.buf, nil
+}
+
+// UnmarshalWKB decodes a WKB LineString with 2 points in either byte order into dst.
+func (dst *Lseg) UnmarshalWKB(src []byte) error {
+	if src == nil {
+		*dst = Lseg{Status: Null}
+		return nil
+	}
+
+	g, err := parseWKB(src)
+	if err != nil {
+		return err
+	}
+	if g.typ != "LINESTRING" || g.empty {
+		return errWKBType(g, dst)
+	}
+	if len(g.rings[0]) != 2 {
+		return fmt.Errorf("WKB LineString must have 2 points to decode into Lseg, has %d", len(g.rings[0]))
+	}
+
+	*dst = Lseg{P: [2]Vec2{g.rings[0][0], g.rings[0][1]}, Status: Present}
+	return nil
+}
+
+// MarshalWKB returns src as a WKB LineString if it is open and as a Polygon if it is closed in byte order, which must
+// be binary.BigEndian or binary.LittleEndian.
+func (src Path) MarshalWKB(byteOrder binary.ByteOrder) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	if src.Closed {
+		return marshalWKBPolygon(byteOrder, src.P)
+	}
+
+	w, err := newWKBWriter(byteOrder, wkbLineString)
+	if err != nil {
+		return nil, err
+	}
+	w.points(src.P, false)
+	return w.buf, nil
+}
+
+// UnmarshalWKB decodes a WKB LineString into an open path or a Polygon into a closed path in either byte order.
+func (dst *Path) UnmarshalWKB(src []byte) error {
+	if src == nil {
+		*dst = Path{Status: Null}
+		return nil
+	}
+
+	g, err := parseWKB(src)
+	if err != nil {
+		return err
+	}
+
+	switch g.typ {
+	case "LINESTRING":
+		*dst = Path{P: g.points(), Status: Present}
+	case "POLYGON":
+		points, err := g.polygon()
+		if err != nil {
+			return err
+		}
+		*dst = Path{P: points, Closed: true, Status: Present}
+	default:
+		return errWKBType(g, dst)
+	}
+	return nil
+}
+
+// MarshalWKB returns src as a WKB Polygon in byte order, which must be binary.BigEndian or binary.LittleEndian.
+func (src Polygon) MarshalWKB(byteOrder binary.ByteOrder) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	return marshalWKBPolygon(byteOrder, src.P)
+}
+
+// UnmarshalWKB decodes a WKB Polygon without holes in either byte order into dst.
+func (dst *Polygon) UnmarshalWKB(src []byte) error {
+	if src == nil {
+		*dst = Polygon{Status: Null}
+		return nil
+	}
+
+	g, err := parseWKB(src)
+	if err != nil {
+		return err
+	}
+	if g.typ != "POLYGON" {
+		return errWKBType(g, dst)
+	}
+
+	points, err := g.polygon()
+	if err != nil {
+		return err
+	}
+	*dst = Polygon{P: points, Status: Present}
+	return nil
+}
+
+// MarshalWKB returns src as a WKB Polygon starting at the lower left corner and running counterclockwise in byte
+// order, which must be binary.BigEndian or binary.LittleEndian.
+func (src Box) MarshalWKB(byteOrder binary.ByteOrder) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	return marshalWKBPolygon(byteOrder, src.rectangle())
+}
+
+// UnmarshalWKB decodes a WKB Polygon in either byte order into dst. The polygon must have 4 vertices at the corners of
+// an axis-aligned rectangle.
+func (dst *Box) UnmarshalWKB(src []byte) error {
+	if src == nil {
+		*dst = Box{Status: Null}
+		return nil
+	}
+
+	g, err := parseWKB(src)
+	if err != nil {
+		return err
+	}
+	if g.typ != "POLYGON" || g.empty {
+		return errWKBType(g, dst)
+	}
+
+	points, err := g.polygon()
+	if err != nil {
+		return err
+	}
+	box, ok := boxFromRectangle(points)
+	if !ok {
+		return errors.New("WKB Polygon is not an axis-aligned rectangle")
+	}
+	*dst = box
+	return nil
+}
+
+func marshalWKBPolygon(byteOrder binary.ByteOrder, points []Vec2) ([]byte, error) {
+	w, err := newWKBWriter(byteOrder, wkbPolygon)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(points) == 0 {
+		w.uint32(0)
+	} else {
+		w.uint32(1)
+		w.points(points, true)
+	}
+	return w.buf, nil
+}
+
+type wkbWriter struct {
+	byteOrder binary.ByteOrder
+	buf       []byte
+}
+
+func newWKBWriter(byteOrder binary.ByteOrder, geometryType uint32) (*wkbWriter, error) {
+	w := &wkbWriter{byteOrder: byteOrder}
+	switch byteOrder {
+	case binary.BigEndian:
+		w.buf = append(w.buf, wkbXDR)
+	case binary.LittleEndian:
+		w.buf = append(w.buf, wkbNDR)
+	default:
+		return nil, fmt.Errorf("unsupported WKB byte order %v", byteOrder)
+	}
+
+	w.uint32(geometryType)
+	return w, nil
+}
+
+func (w *wkbWriter) uint32(n uint32) {
+	var b [4]byte
+	w.byteOrder.PutUint32(b[:], n)
+	w.buf = append(w.buf, b[:]...)
+}
+
+func (w *wkbWriter) float64(f float64) {
+	var b [8]byte
+	w.byteOrder.PutUint64(b[:], math.Float64bits(f))
+	w.buf = append(w.buf, b[:]...)
+}
+
+func (w *wkbWriter) position(p Vec2) {
+	w.float64(p.X)
+	w.float64(p.Y)
+}
+
+// points writes the number of points followed by points, repeating the first point at the end if close.
+func (w *wkbWriter) points(points []Vec2, close bool) {
+	n := len(points)
+	if close {
+		n++
+	}
+	w.uint32(uint32(n))
+	for _, p := range points {
+		w.position(p)
+	}
+	if close {
+		w.position(points[0])
+	}
+}
+
+func errWKBType(g *wktGeometry, dst interface{}) error {
+	typ := g.typ
+	if g.empty {
+		typ += " EMPTY"
+	}
+	return fmt.Errorf("cannot decode WKB %s into %T", typ, dst)
+}
+
+type wkbReader struct {
+	byteOrder binary.ByteOrder
+	src       []byte
+}
+
+// parseWKB parses a two-dimensional WKB Point, LineString or Polygon into the same representation as parseWKT. A
+// Point with NaN coordinates is an empty point.
+func parseWKB(src []byte) (*wktGeometry, error) {
+	if len(src) < 5 {
+		return nil, fmt.Errorf("invalid length for WKB: %v", len(src))
+	}
+
+	r := &wkbReader{src: src[1:]}
+	switch src[0] {
+	case wkbXDR:
+		r.byteOrder = binary.BigEndian
+	case wkbNDR:
+		r.byteOrder = binary.LittleEndian
+	default:
+		return nil, fmt.Errorf("invalid WKB byte order %d", src[0])
+	}
+
+	geometryType, err := r.uint32()
+	if err != nil {
+		return nil, err
+	}
+
+	g := &wktGeometry{}
+	switch geometryType {
+	case wkbPoint:
+		g.typ = "POINT"
+		var p Vec2
+		p, err = r.position()
+		g.rings = [][]Vec2{{p}}
+		g.empty = math.IsNaN(p.X) && math.IsNaN(p.Y)
+	case wkbLineString:
+		g.typ = "LINESTRING"
+		var points []Vec2
+		points, err = r.points()
+		g.rings = [][]Vec2{points}
+		g.empty = len(points) == 0
+	case wkbPolygon:
+		g.typ = "POLYGON"
+		var n uint32
+		n, err = r.uint32()
+		for i := uint32(0); err == nil && i < n; i++ {
+			var points []Vec2
+			points, err = r.points()
+			g.rings = append(g.rings, points)
+		}
+		g.empty = n == 0
+	default:
+		return nil, fmt.Errorf("unsupported WKB geometry type %d", geometryType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r.src) != 0 {
+		return nil, fmt.Errorf("invalid WKB: %d unexpected trailing bytes", len(r.src))
+	}
+	if g.typ == "LINESTRING" && len(g.rings[0]) == 1 {
+		return nil, errors.New("WKB LineString must have at least 2 points")
+	}
+	return g, nil
+}
+
+func (r *wkbReader) uint32() (uint32, error) {
+	if len(r.src) < 4 {
+		return 0, errors.New("invalid WKB: unexpected end of data")
+	}
+	n := r.byteOrder.Uint32(r.src)
+	r.src = r.src[4:]
+	return n, nil
+}
+
+func (r *wkbReader) position() (Vec2, error) {
+	if len(r.src) < 16 {
+		return Vec2{}, errors.New("invalid WKB: unexpected end of data")
+	}
+	p := Vec2{
+		X: math.Float64frombits(r.byteOrder.Uint64(r.src)),
+		Y: math.Float64frombits(r.byteOrder.Uint64(r.src[8:])),
+	}
+	r.src = r.src[16:]
+	return p, nil
+}
+
+func (r *wkbReader) points() ([]Vec2, error) {
+	n, err := r.uint32()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(n)*16 > uint64(len(r.src)) {
+		return nil, errors.New("invalid WKB: unexpected end of data")
+	}
+
+	points := make([]Vec2, n)
+	for i := range points {
+		points[i], err = r.position()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return points, nil
+}
@@ -0,0 +1,127 @@
+package pgtype_test
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type wkbValue interface {
+	MarshalWKB(byteOrder binary.ByteOrder) ([]byte, error)
+}
+
+type wkbDst interface {
+	UnmarshalWKB(src []byte) error
+}
+
+func mustDecodeHex(t testing.TB, s string) []byte {
+	buf, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return buf
+}
+
+func TestMarshalWKB(t *testing.T) {
+	point := pgtype.Point{P: pgtype.Vec2{X: 1, Y: 2}, Status: pgtype.Present}
+
+	buf, err := point.MarshalWKB(binary.LittleEndian)
+	require.NoError(t, err)
+	assert.Equal(t, "0101000000000000000000f03f0000000000000040", hex.EncodeToString(buf))
+
+	buf, err = point.MarshalWKB(binary.BigEndian)
+	require.NoError(t, err)
+	assert.Equal(t, "00000000013ff00000000000004000000000000000", hex.EncodeToString(buf))
+
+	lseg := pgtype.Lseg{P: [2]pgtype.Vec2{{X: 1, Y: 2}, {X: 3, Y: 4}}, Status: pgtype.Present}
+	buf, err = lseg.MarshalWKB(binary.LittleEndian)
+	require.NoError(t, err)
+	assert.Equal(t, "010200000002000000000000000000f03f000000000000004000000000000008400000000000001040", hex.EncodeToString(buf))
+
+	buf, err = pgtype.Point{Status: pgtype.Null}.MarshalWKB(binary.LittleEndian)
+	require.NoError(t, err)
+	assert.Nil(t, buf)
+
+	_, err = pgtype.Point{}.MarshalWKB(binary.LittleEndian)
+	require.Error(t, err)
+	_, err = point.MarshalWKB(nil)
+	require.Error(t, err)
+}
+
+func TestWKBRoundTrip(t *testing.T) {
+	tests := []struct {
+		src wkbValue
+		dst wkbDst
+	}{
+		{src: pgtype.Point{P: pgtype.Vec2{X: 1.5, Y: -2}, Status: pgtype.Present}, dst: &pgtype.Point{}},
+		{src: pgtype.Lseg{P: [2]pgtype.Vec2{{X: 1, Y: 2}, {X: 3, Y: 4}}, Status: pgtype.Present}, dst: &pgtype.Lseg{}},
+		{src: pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Status: pgtype.Present}, dst: &pgtype.Path{}},
+		{src: pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Closed: true, Status: pgtype.Present}, dst: &pgtype.Path{}},
+		{src: pgtype.Polygon{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Status: pgtype.Present}, dst: &pgtype.Polygon{}},
+		{src: pgtype.Box{P: [2]pgtype.Vec2{{X: 3, Y: 4}, {X: 1, Y: 2}}, Status: pgtype.Present}, dst: &pgtype.Box{}},
+	}
+
+	for i, tt := range tests {
+		for _, byteOrder := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+			buf, err := tt.src.MarshalWKB(byteOrder)
+			require.NoErrorf(t, err, "%d %v", i, byteOrder)
+			err = tt.dst.UnmarshalWKB(buf)
+			require.NoErrorf(t, err, "%d %v", i, byteOrder)
+			assert.Equalf(t, tt.src, derefWKBDst(tt.dst), "%d %v", i, byteOrder)
+		}
+	}
+
+	var polygon pgtype.Polygon
+	buf, err := pgtype.Polygon{Status: pgtype.Present}.MarshalWKB(binary.LittleEndian)
+	require.NoError(t, err)
+	assert.Equal(t, "010300000000000000", hex.EncodeToString(buf))
+	require.NoError(t, polygon.UnmarshalWKB(buf))
+	assert.Equal(t, pgtype.Polygon{Status: pgtype.Present}, polygon)
+
+	require.NoError(t, polygon.UnmarshalWKB(nil))
+	assert.Equal(t, pgtype.Polygon{Status: pgtype.Null}, polygon)
+}
+
+func derefWKBDst(dst wkbDst) interface{} {
+	switch dst := dst.(type) {
+	case *pgtype.Point:
+		return *dst
+	case *pgtype.Lseg:
+		return *dst
+	case *pgtype.Path:
+		return *dst
+	case *pgtype.Polygon:
+		return *dst
+	case *pgtype.Box:
+		return *dst
+	}
+	return nil
+}
+
+func TestUnmarshalWKBErrors(t *testing.T) {
+	tests := []struct {
+		src string
+		dst wkbDst
+	}{
+		{src: "", dst: &pgtype.Point{}},
+		{src: "0201000000000000000000f03f0000000000000040", dst: &pgtype.Point{}},
+		{src: "0101000000000000000000f03f00000000000000", dst: &pgtype.Point{}},
+		{src: "0101000000000000000000f03f000000000000004000", dst: &pgtype.Point{}},
+		{src: "0101000000000000000000f87f000000000000f87f", dst: &pgtype.Point{}},
+		// POINT Z in ISO WKB.
+		{src: "01e9030000000000000000f03f00000000000000400000000000000840", dst: &pgtype.Point{}},
+		// POINT with SRID in EWKB.
+		{src: "0101000020e6100000000000000000f03f0000000000000040", dst: &pgtype.Point{}},
+		{src: "0101000000000000000000f03f0000000000000040", dst: &pgtype.Lseg{}},
+		{src: "010200000001000000000000000000f03f0000000000000040", dst: &pgtype.Path{}},
+		{src: "0102000000ffffffff", dst: &pgtype.Path{}},
+		{src: "01030000000100000004000000000000000000000000000000000000000000000000000000000000000000f03f000000000000f03f000000000000f03f00000000000000000000000000000000", dst: &pgtype.Box{}},
+	}
+
+	for i, tt := range tests {
+		err := tt.dst.UnmarshalWKB(mustDecodeHex(t, tt.src))
+		require.Errorf(t, err, "%d", i)
+	}
+}
@@ -0,0 +1,467 @@
+package pgtype
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Point, Lseg, Path, Polygon and Box can be converted to and from Well-Known Text (WKT) and Well-Known Binary (WKB).
+// Point is a POINT, Lseg and an open Path are a LINESTRING, and a closed Path, Polygon and Box are a POLYGON with a
+// single ring. Vertex order is preserved and rings are closed by repeating the first point. Only two-dimensional
+// geometries are supported.
+//
+// Like EncodeText and DecodeText, marshaling a Null value returns nil and unmarshaling nil returns a Null value. An
+// empty Path or Polygon is an EMPTY geometry.
+
+// MarshalWKT returns src as a WKT POINT.
+func (src Point) MarshalWKT() ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	buf := append([]byte("POINT ("), formatWKTPosition(src.P)...)
+	return append(buf, ')'), nil
+}
+
+// UnmarshalWKT decodes a WKT POINT into dst.
+func (dst *Point) UnmarshalWKT(src []byte) error {
+	if src == nil {
+		*dst = Point{Status: Null}
+		return nil
+	}
+
+	g, err := parseWKT(string(src))
+	if err != nil {
+		return err
+	}
+	if g.typ != "POINT" || g.empty {
+		return errWKTType(g, dst)
+	}
+
+	*dst = Point{P: g.rings[0][0], Status: Present}
+	return nil
+}
+
+// MarshalWKT returns src as a WKT LINESTRING.
+func (src Lseg) MarshalWKT() ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	return appendWKTPoints([]byte("LINESTRING "), src.P[:], false), nil
+}
+
+// UnmarshalWKT decodes a WKT LINESTRING with 2 points into dst.
+func (dst *Lseg) UnmarshalWKT(src []byte) error {
+	if src == nil {
+		*dst = Lseg{Status: Null}
+		return nil
+	}
+
+	g, err := parseWKT(string(src))
+	if err != nil {
+		return err
+	}
+	if g.typ != "LINESTRING" || g.empty {
+		return errWKTType(g, dst)
+	}
+	if len(g.rings[0]) != 2 {
+		return fmt.Errorf("WKT LINESTRING must have 2 points to decode into Lseg, has %d", len(g.rings[0]))
+	}
+
+	*dst = Lseg{P: [2]Vec2{g.rings[0][0], g.rings[0][1]}, Status: Present}
+	return nil
+}
+
+// MarshalWKT returns src as a WKT LINESTRING if it is open and as a POLYGON if it is closed.
+func (src Path) MarshalWKT() ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	if src.Closed {
+		return appendWKTPolygon([]byte("POLYGON "), src.P), nil
+	}
+	return appendWKTPoints([]byte("LINESTRING "), src.P, false), nil
+}
+
+// UnmarshalWKT decodes a WKT LINESTRING into an open path or a POLYGON into a closed path.
+func (dst *Path) UnmarshalWKT(src []byte) error {
+	if src == nil {
+		*dst = Path{Status: Null}
+		return nil
+	}
+
+	g, err := parseWKT(string(src))
+	if err != nil {
+		return err
+	}
+
+	switch g.typ {
+	case "LINESTRING":
+		*dst = Path{P: g.points(), Status: Present}
+	case "POLYGON":
+		points, err := g.polygon()
+		if err != nil {
+			return err
+		}
+		*dst = Path{P: points, Closed: true, Status: Present}
+	default:
+		return errWKTType(g, dst)
+	}
+	return nil
+}
+
+// MarshalWKT returns src as a WKT POLYGON.
+func (src Polygon) MarshalWKT() ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	return appendWKTPolygon([]byte("POLYGON "), src.P), nil
+}
+
+// UnmarshalWKT decodes a WKT POLYGON without holes into dst.
+func (dst *Polygon) UnmarshalWKT(src []byte) error {
+	if src == nil {
+		*dst = Polygon{Status: Null}
+		return nil
+	}
+
+	g, err := parseWKT(string(src))
+	if err != nil {
+		return err
+	}
+	if g.typ != "POLYGON" {
+		return errWKTType(g, dst)
+	}
+
+	points, err := g.polygon()
+	if err != nil {
+		return err
+	}
+	*dst = Polygon{P: points, Status: Present}
+	return nil
+}
+
+// MarshalWKT returns src as a WKT POLYGON starting at the lower left corner and running counterclockwise.
+func (src Box) MarshalWKT() ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	return appendWKTPolygon([]byte("POLYGON "), src.rectangle()), nil
+}
+
+// UnmarshalWKT decodes a WKT POLYGON into dst. The polygon must have 4 vertices at the corners of an axis-aligned
+// rectangle.
+func (dst *Box) UnmarshalWKT(src []byte) error {
+	if src == nil {
+		*dst = Box{Status: Null}
+		return nil
+	}
+
+	g, err := parseWKT(string(src))
+	if err != nil {
+		return err
+	}
+	if g.typ != "POLYGON" || g.empty {
+		return errWKTType(g, dst)
+	}
+
+	points, err := g.polygon()
+	if err != nil {
+		return err
+	}
+	box, ok := boxFromRectangle(points)
+	if !ok {
+		return errors.New("WKT POLYGON is not an axis-aligned rectangle")
+	}
+	*dst = box
+	return nil
+}
+
+// isWKT reports whether src looks like WKT rather than the PostgreSQL text format of a geometric type. WKT starts with
+// the geometry type name.
+func isWKT(src string) bool {
+	src = strings.TrimLeft(src, " \t\n\r")
+	return len(src) > 0 && isWKTLetter(src[0])
+}
+
+func isWKTLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func formatWKTPosition(p Vec2) []byte {
+	buf := strconv.AppendFloat(nil, p.X, 'g', -1, 64)
+	buf = append(buf, ' ')
+	return strconv.AppendFloat(buf, p.Y, 'g', -1, 64)
+}
+
+// appendWKTPoints appends a parenthesized list of points to buf, repeating the first point at the end if close. An
+// empty list is EMPTY.
+func appendWKTPoints(buf []byte, points []Vec2, close bool) []byte {
+	if len(points) == 0 {
+		return append(buf, "EMPTY"...)
+	}
+
+	buf = append(buf, '(')
+	for i, p := range points {
+		if i > 0 {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, formatWKTPosition(p)...)
+	}
+	if close {
+		buf = append(buf, ", "...)
+		buf = append(buf, formatWKTPosition(points[0])...)
+	}
+	return append(buf, ')')
+}
+
+func appendWKTPolygon(buf []byte, points []Vec2) []byte {
+	if len(points) == 0 {
+		return append(buf, "EMPTY"...)
+	}
+
+	buf = append(buf, '(')
+	buf = appendWKTPoints(buf, points, true)
+	return append(buf, ')')
+}
+
+// wktGeometry is a parsed WKT geometry. A POINT has a single ring with a single point and a LINESTRING has a single
+// ring.
+type wktGeometry struct {
+	typ   string
+	empty bool
+	rings [][]Vec2
+}
+
+func (g *wktGeometry) points() []Vec2 {
+	if g.empty {
+		return nil
+	}
+	return g.rings[0]
+}
+
+// polygon returns the vertices of the exterior ring without the closing point. Holes cannot be represented and are an
+// error.
+func (g *wktGeometry) polygon() ([]Vec2, error) {
+	if g.empty {
+		return nil, nil
+	}
+	if len(g.rings) != 1 {
+		return nil, fmt.Errorf("WKT POLYGON must have exactly 1 ring, has %d", len(g.rings))
+	}
+
+	ring := g.rings[0]
+	if len(ring) < 4 {
+		return nil, errors.New("WKT POLYGON ring must have at least 4 points")
+	}
+	if ring[0] != ring[len(ring)-1] {
+		return nil, errors.New("WKT POLYGON ring is not closed")
+	}
+	return ring[:len(ring)-1], nil
+}
+
+func errWKTType(g *wktGeometry, dst interface{}) error {
+	typ := g.typ
+	if g.empty {
+		typ += " EMPTY"
+	}
+	return fmt.Errorf("cannot decode WKT %s into %T", typ, dst)
+}
+
+type wktParser struct {
+	src string
+	pos int
+}
+
+// parseWKT parses a two-dimensional WKT POINT, LINESTRING or POLYGON. Keywords are case-insensitive.
+func parseWKT(src string) (*wktGeometry, error) {
+	p := &wktParser{src: src}
+
+	g := &wktGeometry{typ: strings.ToUpper(p.word())}
+	switch g.typ {
+	case "POINT", "LINESTRING", "POLYGON":
+	case "":
+		return nil, fmt.Errorf("invalid WKT: %q", src)
+	default:
+		return nil, fmt.Errorf("unsupported WKT geometry type %s", g.typ)
+	}
+
+	switch modifier := strings.ToUpper(p.word()); modifier {
+	case "":
+	case "EMPTY":
+		g.empty = true
+	case "Z", "M", "ZM":
+		return nil, fmt.Errorf("unsupported WKT dimensions %s", modifier)
+	default:
+		return nil, fmt.Errorf("invalid WKT: unexpected %s", modifier)
+	}
+
+	if !g.empty {
+		var err error
+		switch g.typ {
+		case "POINT":
+			var pt Vec2
+			pt, err = p.pointText()
+			g.rings = [][]Vec2{{pt}}
+		case "LINESTRING":
+			var points []Vec2
+			points, err = p.pointList()
+			g.rings = [][]Vec2{points}
+		case "POLYGON":
+			g.rings, err = p.ringList()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p.skipSpace()
+	if p.pos != len(p.src) {
+		return nil, fmt.Errorf("invalid WKT: unexpected %q", p.src[p.pos:])
+	}
+	return g, nil
+}
+
+func (p *wktParser) skipSpace() {
+	for p.pos < len(p.src) && strings.IndexByte(" \t\n\r", p.src[p.pos]) != -1 {
+		p.pos++
+	}
+}
+
+func (p *wktParser) word() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.src) && isWKTLetter(p.src[p.pos]) {
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *wktParser) consume(c byte) error {
+	p.skipSpace()
+	if p.pos >= len(p.src) || p.src[p.pos] != c {
+		return fmt.Errorf("invalid WKT: expected %q at offset %d", c, p.pos)
+	}
+	p.pos++
+	return nil
+}
+
+// peek returns the next byte that is not a space or 0 at the end of the input.
+func (p *wktParser) peek() byte {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *wktParser) number() (float64, error) {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.src) && strings.IndexByte("+-.0123456789eE", p.src[p.pos]) != -1 {
+		p.pos++
+	}
+	if start == p.pos {
+		return 0, fmt.Errorf("invalid WKT: expected number at offset %d", start)
+	}
+	return strconv.ParseFloat(p.src[start:p.pos], 64)
+}
+
+func (p *wktParser) position() (Vec2, error) {
+	x, err := p.number()
+	if err != nil {
+		return Vec2{}, err
+	}
+	y, err := p.number()
+	if err != nil {
+		return Vec2{}, err
+	}
+	if c := p.peek(); c != ',' && c != ')' {
+		return Vec2{}, errors.New("unsupported WKT dimensions: only two-dimensional points are supported")
+	}
+	return Vec2{X: x, Y: y}, nil
+}
+
+func (p *wktParser) pointText() (Vec2, error) {
+	err := p.consume('(')
+	if err != nil {
+		return Vec2{}, err
+	}
+	pt, err := p.position()
+	if err != nil {
+		return Vec2{}, err
+	}
+	return pt, p.consume(')')
+}
+
+func (p *wktParser) pointList() ([]Vec2, error) {
+	err := p.consume('(')
+	if err != nil {
+		return nil, err
+	}
+
+	var points []Vec2
+	for {
+		pt, err := p.position()
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, pt)
+		if p.peek() != ',' {
+			break
+		}
+		p.pos++
+	}
+
+	err = p.consume(')')
+	if err != nil {
+		return nil, err
+	}
+	if len(points) < 2 {
+		return nil, errors.New("WKT LINESTRING must have at least 2 points")
+	}
+	return points, nil
+}
+
+func (p *wktParser) ringList() ([][]Vec2, error) {
+	err := p.consume('(')
+	if err != nil {
+		return nil, err
+	}
+
+	var rings [][]Vec2
+	for {
+		ring, err := p.pointList()
+		if err != nil {
+			return nil, err
+		}
+		rings = append(rings, ring)
+		if p.peek() != ',' {
+			break
+		}
+		p.pos++
+	}
+
+	return rings, p.consume(')')
+}
@@ -0,0 +1,164 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type wktValue interface {
+	MarshalWKT() ([]byte, error)
+}
+
+type wktDst interface {
+	UnmarshalWKT(src []byte) error
+}
+
+func TestMarshalWKT(t *testing.T) {
+	tests := []struct {
+		src    wktValue
+		result string
+	}{
+		{src: pgtype.Point{P: pgtype.Vec2{X: 1.5, Y: -2}, Status: pgtype.Present}, result: "POINT (1.5 -2)"},
+		{src: pgtype.Lseg{P: [2]pgtype.Vec2{{X: 1, Y: 2}, {X: 3, Y: 4}}, Status: pgtype.Present}, result: "LINESTRING (1 2, 3 4)"},
+		{
+			src:    pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Status: pgtype.Present},
+			result: "LINESTRING (0 0, 1 0, 1 1)",
+		},
+		{
+			src:    pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Closed: true, Status: pgtype.Present},
+			result: "POLYGON ((0 0, 1 0, 1 1, 0 0))",
+		},
+		{src: pgtype.Path{Status: pgtype.Present}, result: "LINESTRING EMPTY"},
+		{
+			src:    pgtype.Polygon{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Status: pgtype.Present},
+			result: "POLYGON ((0 0, 1 0, 1 1, 0 0))",
+		},
+		{src: pgtype.Polygon{Status: pgtype.Present}, result: "POLYGON EMPTY"},
+		{
+			src:    pgtype.Box{P: [2]pgtype.Vec2{{X: 3, Y: 4}, {X: 1, Y: 2}}, Status: pgtype.Present},
+			result: "POLYGON ((1 2, 3 2, 3 4, 1 4, 1 2))",
+		},
+	}
+
+	for i, tt := range tests {
+		buf, err := tt.src.MarshalWKT()
+		require.NoErrorf(t, err, "%d", i)
+		assert.Equalf(t, tt.result, string(buf), "%d", i)
+	}
+
+	buf, err := pgtype.Point{Status: pgtype.Null}.MarshalWKT()
+	require.NoError(t, err)
+	assert.Nil(t, buf)
+	_, err = pgtype.Box{}.MarshalWKT()
+	require.Error(t, err)
+}
+
+func TestUnmarshalWKT(t *testing.T) {
+	tests := []struct {
+		src    string
+		dst    wktDst
+		result interface{}
+	}{
+		{src: "POINT (1.5 -2)", dst: &pgtype.Point{}, result: &pgtype.Point{P: pgtype.Vec2{X: 1.5, Y: -2}, Status: pgtype.Present}},
+		{src: " point(1e3 +2.5) ", dst: &pgtype.Point{}, result: &pgtype.Point{P: pgtype.Vec2{X: 1000, Y: 2.5}, Status: pgtype.Present}},
+		{
+			src:    "LINESTRING(1 2,3 4)",
+			dst:    &pgtype.Lseg{},
+			result: &pgtype.Lseg{P: [2]pgtype.Vec2{{X: 1, Y: 2}, {X: 3, Y: 4}}, Status: pgtype.Present},
+		},
+		{
+			src:    "LINESTRING (0 0, 1 0, 1 1)",
+			dst:    &pgtype.Path{},
+			result: &pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Status: pgtype.Present},
+		},
+		{
+			src:    "POLYGON ((0 0, 1 0, 1 1, 0 0))",
+			dst:    &pgtype.Path{},
+			result: &pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Closed: true, Status: pgtype.Present},
+		},
+		{src: "LINESTRING EMPTY", dst: &pgtype.Path{}, result: &pgtype.Path{Status: pgtype.Present}},
+		{
+			src:    "Polygon ((0 0, 1 0, 1 1, 0 0))",
+			dst:    &pgtype.Polygon{},
+			result: &pgtype.Polygon{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Status: pgtype.Present},
+		},
+		{src: "POLYGON EMPTY", dst: &pgtype.Polygon{}, result: &pgtype.Polygon{Status: pgtype.Present}},
+		{
+			src:    "POLYGON ((3 4, 1 4, 1 2, 3 2, 3 4))",
+			dst:    &pgtype.Box{},
+			result: &pgtype.Box{P: [2]pgtype.Vec2{{X: 3, Y: 4}, {X: 1, Y: 2}}, Status: pgtype.Present},
+		},
+	}
+
+	for i, tt := range tests {
+		err := tt.dst.UnmarshalWKT([]byte(tt.src))
+		require.NoErrorf(t, err, "%d", i)
+		assert.Equalf(t, tt.result, tt.dst, "%d", i)
+	}
+
+	var p pgtype.Point
+	require.NoError(t, p.UnmarshalWKT(nil))
+	assert.Equal(t, pgtype.Point{Status: pgtype.Null}, p)
+
+	errorTests := []struct {
+		src string
+		dst wktDst
+	}{
+		{src: "", dst: &pgtype.Point{}},
+		{src: "POINT EMPTY", dst: &pgtype.Point{}},
+		{src: "POINT (1)", dst: &pgtype.Point{}},
+		{src: "POINT (1 2 3)", dst: &pgtype.Point{}},
+		{src: "POINT Z (1 2 3)", dst: &pgtype.Point{}},
+		{src: "POINT (1 2) x", dst: &pgtype.Point{}},
+		{src: "POINT (1 2", dst: &pgtype.Point{}},
+		{src: "MULTIPOINT ((1 2))", dst: &pgtype.Point{}},
+		{src: "LINESTRING (1 2)", dst: &pgtype.Path{}},
+		{src: "LINESTRING (1 2, 3 4, 5 6)", dst: &pgtype.Lseg{}},
+		{src: "POINT (1 2)", dst: &pgtype.Lseg{}},
+		{src: "POLYGON ((0 0, 1 0, 1 1, 0 1))", dst: &pgtype.Polygon{}},
+		{src: "POLYGON ((0 0, 4 0, 4 4, 0 0), (1 1, 2 1, 2 2, 1 1))", dst: &pgtype.Polygon{}},
+		{src: "POLYGON ((0 0, 1 0, 1 1, 0 0))", dst: &pgtype.Box{}},
+	}
+
+	for i, tt := range errorTests {
+		err := tt.dst.UnmarshalWKT([]byte(tt.src))
+		require.Errorf(t, err, "%d: %s", i, tt.src)
+	}
+}
+
+func TestGeometricSetWKT(t *testing.T) {
+	var point pgtype.Point
+	require.NoError(t, point.Set("POINT (1 2)"))
+	assert.Equal(t, pgtype.Point{P: pgtype.Vec2{X: 1, Y: 2}, Status: pgtype.Present}, point)
+	require.NoError(t, point.Set("(3,4)"))
+	assert.Equal(t, pgtype.Point{P: pgtype.Vec2{X: 3, Y: 4}, Status: pgtype.Present}, point)
+
+	var lseg pgtype.Lseg
+	require.NoError(t, lseg.Set("LINESTRING (1 2, 3 4)"))
+	assert.Equal(t, pgtype.Lseg{P: [2]pgtype.Vec2{{X: 1, Y: 2}, {X: 3, Y: 4}}, Status: pgtype.Present}, lseg)
+	require.NoError(t, lseg.Set("[(5,6),(7,8)]"))
+	assert.Equal(t, pgtype.Lseg{P: [2]pgtype.Vec2{{X: 5, Y: 6}, {X: 7, Y: 8}}, Status: pgtype.Present}, lseg)
+	require.NoError(t, lseg.Set(nil))
+	assert.Equal(t, pgtype.Lseg{Status: pgtype.Null}, lseg)
+	require.Error(t, lseg.Set(1))
+
+	var path pgtype.Path
+	require.NoError(t, path.Set("POLYGON ((0 0, 1 0, 1 1, 0 0))"))
+	assert.Equal(t, pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Closed: true, Status: pgtype.Present}, path)
+	require.NoError(t, path.Set("[(0,0),(1,1)]"))
+	assert.Equal(t, pgtype.Path{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 1}}, Status: pgtype.Present}, path)
+
+	var polygon pgtype.Polygon
+	require.NoError(t, polygon.Set("POLYGON ((0 0, 1 0, 1 1, 0 0))"))
+	assert.Equal(t, pgtype.Polygon{P: []pgtype.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}, Status: pgtype.Present}, polygon)
+
+	var box pgtype.Box
+	require.NoError(t, box.Set("POLYGON ((1 2, 3 2, 3 4, 1 4, 1 2))"))
+	assert.Equal(t, pgtype.Box{P: [2]pgtype.Vec2{{X: 3, Y: 4}, {X: 1, Y: 2}}, Status: pgtype.Present}, box)
+	require.NoError(t, box.Set("(3,4),(1,2)"))
+	assert.Equal(t, pgtype.Box{P: [2]pgtype.Vec2{{X: 3, Y: 4}, {X: 1, Y: 2}}, Status: pgtype.Present}, box)
+	require.Error(t, box.Set("POINT (1 2)"))
+}
@@ -0,0 +1,126 @@
+package zeronull
+
+import (
+	"database/sql/driver"
+
+	"github.com/jackc/pgtype"
+)
+
+type Bool bool
+
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *Bool) Set(src interface{}) error {
+	var nullable pgtype.Bool
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Bool(nullable.Bool)
+	} else {
+		*dst = false
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src Bool) Get() interface{} {
+	if src == false {
+		return nil
+	}
+
+	return bool(src)
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *Bool) AssignTo(dst interface{}) error {
+	nullable := pgtype.Bool{
+		Bool:   bool(*src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
+func (dst *Bool) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Bool
+	err := nullable.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Bool(nullable.Bool)
+	} else {
+		*dst = false
+	}
+
+	return nil
+}
+
+func (dst *Bool) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Bool
+	err := nullable.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Bool(nullable.Bool)
+	} else {
+		*dst = false
+	}
+
+	return nil
+}
+
+func (src Bool) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if src == false {
+		return nil, nil
+	}
+
+	nullable := pgtype.Bool{
+		Bool:   bool(src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeText(ci, buf)
+}
+
+func (src Bool) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if src == false {
+		return nil, nil
+	}
+
+	nullable := pgtype.Bool{
+		Bool:   bool(src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Bool) Scan(src interface{}) error {
+	if src == nil {
+		*dst = false
+		return nil
+	}
+
+	var nullable pgtype.Bool
+	err := nullable.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	*dst = Bool(nullable.Bool)
+
+	return nil
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Bool) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
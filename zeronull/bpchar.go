@@ -0,0 +1,126 @@
+package zeronull
+
+import (
+	"database/sql/driver"
+
+	"github.com/jackc/pgtype"
+)
+
+type BPChar string
+
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *BPChar) Set(src interface{}) error {
+	var nullable pgtype.BPChar
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = BPChar(nullable.String)
+	} else {
+		*dst = BPChar("")
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src BPChar) Get() interface{} {
+	if src == BPChar("") {
+		return nil
+	}
+
+	return string(src)
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *BPChar) AssignTo(dst interface{}) error {
+	nullable := pgtype.BPChar{
+		String: string(*src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
+func (dst *BPChar) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.BPChar
+	err := nullable.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = BPChar(nullable.String)
+	} else {
+		*dst = BPChar("")
+	}
+
+	return nil
+}
+
+func (dst *BPChar) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.BPChar
+	err := nullable.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = BPChar(nullable.String)
+	} else {
+		*dst = BPChar("")
+	}
+
+	return nil
+}
+
+func (src BPChar) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if src == BPChar("") {
+		return nil, nil
+	}
+
+	nullable := pgtype.BPChar{
+		String: string(src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeText(ci, buf)
+}
+
+func (src BPChar) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if src == BPChar("") {
+		return nil, nil
+	}
+
+	nullable := pgtype.BPChar{
+		String: string(src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *BPChar) Scan(src interface{}) error {
+	if src == nil {
+		*dst = BPChar("")
+		return nil
+	}
+
+	var nullable pgtype.BPChar
+	err := nullable.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	*dst = BPChar(nullable.String)
+
+	return nil
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src BPChar) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
@@ -0,0 +1,126 @@
+package zeronull
+
+import (
+	"database/sql/driver"
+
+	"github.com/jackc/pgtype"
+)
+
+type Bytea []byte
+
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *Bytea) Set(src interface{}) error {
+	var nullable pgtype.Bytea
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Bytea(nullable.Bytes)
+	} else {
+		*dst = nil
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src Bytea) Get() interface{} {
+	if len(src) == 0 {
+		return nil
+	}
+
+	return []byte(src)
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *Bytea) AssignTo(dst interface{}) error {
+	nullable := pgtype.Bytea{
+		Bytes:  []byte(*src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
+func (dst *Bytea) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Bytea
+	err := nullable.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Bytea(nullable.Bytes)
+	} else {
+		*dst = nil
+	}
+
+	return nil
+}
+
+func (dst *Bytea) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Bytea
+	err := nullable.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Bytea(nullable.Bytes)
+	} else {
+		*dst = nil
+	}
+
+	return nil
+}
+
+func (src Bytea) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if len(src) == 0 {
+		return nil, nil
+	}
+
+	nullable := pgtype.Bytea{
+		Bytes:  []byte(src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeText(ci, buf)
+}
+
+func (src Bytea) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if len(src) == 0 {
+		return nil, nil
+	}
+
+	nullable := pgtype.Bytea{
+		Bytes:  []byte(src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Bytea) Scan(src interface{}) error {
+	if src == nil {
+		*dst = nil
+		return nil
+	}
+
+	var nullable pgtype.Bytea
+	err := nullable.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	*dst = Bytea(nullable.Bytes)
+
+	return nil
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Bytea) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
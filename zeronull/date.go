@@ -0,0 +1,127 @@
+package zeronull
+
+import (
+	"database/sql/driver"
+	"time"
+
+	"github.com/jackc/pgtype"
+)
+
+type Date time.Time
+
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *Date) Set(src interface{}) error {
+	var nullable pgtype.Date
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Date(nullable.Time)
+	} else {
+		*dst = Date{}
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src Date) Get() interface{} {
+	if (src == Date{}) {
+		return nil
+	}
+
+	return time.Time(src)
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *Date) AssignTo(dst interface{}) error {
+	nullable := pgtype.Date{
+		Time:   time.Time(*src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
+func (dst *Date) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Date
+	err := nullable.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Date(nullable.Time)
+	} else {
+		*dst = Date{}
+	}
+
+	return nil
+}
+
+func (dst *Date) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Date
+	err := nullable.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Date(nullable.Time)
+	} else {
+		*dst = Date{}
+	}
+
+	return nil
+}
+
+func (src Date) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if (src == Date{}) {
+		return nil, nil
+	}
+
+	nullable := pgtype.Date{
+		Time:   time.Time(src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeText(ci, buf)
+}
+
+func (src Date) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if (src == Date{}) {
+		return nil, nil
+	}
+
+	nullable := pgtype.Date{
+		Time:   time.Time(src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Date) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Date{}
+		return nil
+	}
+
+	var nullable pgtype.Date
+	err := nullable.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	*dst = Date(nullable.Time)
+
+	return nil
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Date) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
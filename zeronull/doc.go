@@ -18,5 +18,10 @@ middlename would be stored as a NULL.
 			zeronull.Text(middlename),
 			zeronull.Text(lastname),
 		)
+
+Alternatively, Register replaces the standard data types in a ConnInfo with the types in this package. Then all
+values of those types are converted between NULL and the zero value without any conversion at usage time.
+
+		zeronull.Register(conn.ConnInfo())
 */
 package zeronull
@@ -0,0 +1,126 @@
+package zeronull
+
+import (
+	"database/sql/driver"
+
+	"github.com/jackc/pgtype"
+)
+
+type Float4 float32
+
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *Float4) Set(src interface{}) error {
+	var nullable pgtype.Float4
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Float4(nullable.Float)
+	} else {
+		*dst = 0
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src Float4) Get() interface{} {
+	if src == 0 {
+		return nil
+	}
+
+	return float32(src)
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *Float4) AssignTo(dst interface{}) error {
+	nullable := pgtype.Float4{
+		Float:  float32(*src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
+func (dst *Float4) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Float4
+	err := nullable.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Float4(nullable.Float)
+	} else {
+		*dst = 0
+	}
+
+	return nil
+}
+
+func (dst *Float4) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Float4
+	err := nullable.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Float4(nullable.Float)
+	} else {
+		*dst = 0
+	}
+
+	return nil
+}
+
+func (src Float4) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if src == 0 {
+		return nil, nil
+	}
+
+	nullable := pgtype.Float4{
+		Float:  float32(src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeText(ci, buf)
+}
+
+func (src Float4) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if src == 0 {
+		return nil, nil
+	}
+
+	nullable := pgtype.Float4{
+		Float:  float32(src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Float4) Scan(src interface{}) error {
+	if src == nil {
+		*dst = 0
+		return nil
+	}
+
+	var nullable pgtype.Float4
+	err := nullable.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	*dst = Float4(nullable.Float)
+
+	return nil
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Float4) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
@@ -8,6 +8,42 @@ import (
 
 type Float8 float64
 
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *Float8) Set(src interface{}) error {
+	var nullable pgtype.Float8
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Float8(nullable.Float)
+	} else {
+		*dst = 0
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src Float8) Get() interface{} {
+	if src == 0 {
+		return nil
+	}
+
+	return float64(src)
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *Float8) AssignTo(dst interface{}) error {
+	nullable := pgtype.Float8{
+		Float:  float64(*src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
 func (dst *Float8) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
 	var nullable pgtype.Float8
 	err := nullable.DecodeText(ci, src)
@@ -0,0 +1,132 @@
+package zeronull
+
+import (
+	"database/sql/driver"
+	"net"
+
+	"github.com/jackc/pgtype"
+)
+
+// Inet is an inet where an IPNet without an IP is NULL.
+type Inet net.IPNet
+
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *Inet) Set(src interface{}) error {
+	var nullable pgtype.Inet
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Inet(*nullable.IPNet)
+	} else {
+		*dst = Inet{}
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src Inet) Get() interface{} {
+	if len(src.IP) == 0 {
+		return nil
+	}
+
+	ipnet := net.IPNet(src)
+	return &ipnet
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *Inet) AssignTo(dst interface{}) error {
+	ipnet := net.IPNet(*src)
+	nullable := pgtype.Inet{
+		IPNet:  &ipnet,
+		Status: pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
+func (dst *Inet) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Inet
+	err := nullable.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Inet(*nullable.IPNet)
+	} else {
+		*dst = Inet{}
+	}
+
+	return nil
+}
+
+func (dst *Inet) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Inet
+	err := nullable.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Inet(*nullable.IPNet)
+	} else {
+		*dst = Inet{}
+	}
+
+	return nil
+}
+
+func (src Inet) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if len(src.IP) == 0 {
+		return nil, nil
+	}
+
+	ipnet := net.IPNet(src)
+	nullable := pgtype.Inet{
+		IPNet:  &ipnet,
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeText(ci, buf)
+}
+
+func (src Inet) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if len(src.IP) == 0 {
+		return nil, nil
+	}
+
+	ipnet := net.IPNet(src)
+	nullable := pgtype.Inet{
+		IPNet:  &ipnet,
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Inet) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Inet{}
+		return nil
+	}
+
+	var nullable pgtype.Inet
+	err := nullable.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	*dst = Inet(*nullable.IPNet)
+
+	return nil
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Inet) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
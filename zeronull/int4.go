@@ -8,6 +8,42 @@ import (
 
 type Int4 int32
 
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *Int4) Set(src interface{}) error {
+	var nullable pgtype.Int4
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Int4(nullable.Int)
+	} else {
+		*dst = 0
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src Int4) Get() interface{} {
+	if src == 0 {
+		return nil
+	}
+
+	return int32(src)
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *Int4) AssignTo(dst interface{}) error {
+	nullable := pgtype.Int4{
+		Int:    int32(*src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
 func (dst *Int4) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
 	var nullable pgtype.Int4
 	err := nullable.DecodeText(ci, src)
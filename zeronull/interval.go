@@ -0,0 +1,116 @@
+package zeronull
+
+import (
+	"database/sql/driver"
+
+	"github.com/jackc/pgtype"
+)
+
+// Interval is an interval where the zero interval is NULL. Months, days and microseconds are kept separately like
+// pgtype.Interval so values such as 1 mon are not changed by a round trip.
+type Interval struct {
+	Microseconds int64
+	Days         int32
+	Months       int32
+}
+
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *Interval) Set(src interface{}) error {
+	switch value := src.(type) {
+	case Interval:
+		*dst = value
+		return nil
+	case pgtype.Interval:
+		dst.setNullable(&value)
+		return nil
+	}
+
+	var nullable pgtype.Interval
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	dst.setNullable(&nullable)
+	return nil
+}
+
+func (dst *Interval) setNullable(nullable *pgtype.Interval) {
+	if nullable.Status != pgtype.Present {
+		*dst = Interval{}
+		return
+	}
+
+	*dst = Interval{Microseconds: nullable.Microseconds, Days: nullable.Days, Months: nullable.Months}
+}
+
+func (src Interval) nullable() pgtype.Interval {
+	if src == (Interval{}) {
+		return pgtype.Interval{Status: pgtype.Null}
+	}
+
+	return pgtype.Interval{Microseconds: src.Microseconds, Days: src.Days, Months: src.Months, Status: pgtype.Present}
+}
+
+// Get returns nil if src is the zero value.
+func (src Interval) Get() interface{} {
+	return src.nullable().Get()
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *Interval) AssignTo(dst interface{}) error {
+	nullable := pgtype.Interval{Microseconds: src.Microseconds, Days: src.Days, Months: src.Months, Status: pgtype.Present}
+	return nullable.AssignTo(dst)
+}
+
+func (dst *Interval) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Interval
+	err := nullable.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+
+	dst.setNullable(&nullable)
+	return nil
+}
+
+func (dst *Interval) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Interval
+	err := nullable.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	dst.setNullable(&nullable)
+	return nil
+}
+
+func (src Interval) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	return src.nullable().EncodeText(ci, buf)
+}
+
+func (src Interval) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	return src.nullable().EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Interval) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Interval{}
+		return nil
+	}
+
+	var nullable pgtype.Interval
+	err := nullable.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	dst.setNullable(&nullable)
+	return nil
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Interval) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
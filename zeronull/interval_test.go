@@ -0,0 +1,45 @@
+package zeronull_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/zeronull"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntervalKeepsMonthsAndDays(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	zeronull.Register(ci)
+
+	var iv zeronull.Interval
+	err := ci.Scan(pgtype.IntervalOID, pgtype.TextFormatCode, []byte("1 mon 2 days 00:00:03"), &iv)
+	require.NoError(t, err)
+	require.Equal(t, zeronull.Interval{Microseconds: 3000000, Days: 2, Months: 1}, iv)
+
+	buf, err := iv.EncodeText(ci, nil)
+	require.NoError(t, err)
+	require.Equal(t, "1 mon 2 day 00:00:03.000000", string(buf))
+
+	buf, err = iv.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+	var binary zeronull.Interval
+	require.NoError(t, binary.DecodeBinary(ci, buf))
+	require.Equal(t, iv, binary)
+
+	var d time.Duration
+	require.NoError(t, iv.AssignTo(&d))
+	require.Equal(t, 32*24*time.Hour+3*time.Second, d)
+
+	require.NoError(t, iv.Set(time.Minute))
+	require.Equal(t, zeronull.Interval{Microseconds: 60000000}, iv)
+
+	require.NoError(t, iv.Set(nil))
+	require.Equal(t, zeronull.Interval{}, iv)
+	require.Nil(t, iv.Get())
+
+	buf, err = iv.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+	require.Nil(t, buf)
+}
@@ -0,0 +1,126 @@
+package zeronull
+
+import (
+	"database/sql/driver"
+
+	"github.com/jackc/pgtype"
+)
+
+type JSONB []byte
+
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *JSONB) Set(src interface{}) error {
+	var nullable pgtype.JSONB
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = JSONB(nullable.Bytes)
+	} else {
+		*dst = nil
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src JSONB) Get() interface{} {
+	if len(src) == 0 {
+		return nil
+	}
+
+	return []byte(src)
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *JSONB) AssignTo(dst interface{}) error {
+	nullable := pgtype.JSONB{
+		Bytes:  []byte(*src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
+func (dst *JSONB) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.JSONB
+	err := nullable.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = JSONB(nullable.Bytes)
+	} else {
+		*dst = nil
+	}
+
+	return nil
+}
+
+func (dst *JSONB) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.JSONB
+	err := nullable.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = JSONB(nullable.Bytes)
+	} else {
+		*dst = nil
+	}
+
+	return nil
+}
+
+func (src JSONB) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if len(src) == 0 {
+		return nil, nil
+	}
+
+	nullable := pgtype.JSONB{
+		Bytes:  []byte(src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeText(ci, buf)
+}
+
+func (src JSONB) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if len(src) == 0 {
+		return nil, nil
+	}
+
+	nullable := pgtype.JSONB{
+		Bytes:  []byte(src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *JSONB) Scan(src interface{}) error {
+	if src == nil {
+		*dst = nil
+		return nil
+	}
+
+	var nullable pgtype.JSONB
+	err := nullable.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	*dst = JSONB(nullable.Bytes)
+
+	return nil
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src JSONB) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
@@ -0,0 +1,146 @@
+package zeronull
+
+import (
+	"database/sql/driver"
+	"math/big"
+
+	"github.com/jackc/pgtype"
+)
+
+// Numeric is a numeric where zero is NULL. NaN and infinity are not zero.
+type Numeric struct {
+	Int              *big.Int
+	Exp              int32
+	NaN              bool
+	InfinityModifier pgtype.InfinityModifier
+}
+
+func (src Numeric) isZero() bool {
+	return (src.Int == nil || src.Int.Sign() == 0) && !src.NaN && src.InfinityModifier == pgtype.None
+}
+
+func (src Numeric) nullable() pgtype.Numeric {
+	return pgtype.Numeric{
+		Int:              src.Int,
+		Exp:              src.Exp,
+		NaN:              src.NaN,
+		InfinityModifier: src.InfinityModifier,
+		Status:           pgtype.Present,
+	}
+}
+
+func (dst *Numeric) setNullable(nullable *pgtype.Numeric) {
+	if nullable.Status == pgtype.Present {
+		*dst = Numeric{
+			Int:              nullable.Int,
+			Exp:              nullable.Exp,
+			NaN:              nullable.NaN,
+			InfinityModifier: nullable.InfinityModifier,
+		}
+	} else {
+		*dst = Numeric{}
+	}
+}
+
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *Numeric) Set(src interface{}) error {
+	// pgtype.Numeric.Set does not accept the pgtype.Numeric returned by Get.
+	switch value := src.(type) {
+	case Numeric:
+		*dst = value
+		return nil
+	case pgtype.Numeric:
+		dst.setNullable(&value)
+		return nil
+	}
+
+	var nullable pgtype.Numeric
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	dst.setNullable(&nullable)
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src Numeric) Get() interface{} {
+	if src.isZero() {
+		return nil
+	}
+
+	return src.nullable()
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *Numeric) AssignTo(dst interface{}) error {
+	nullable := src.nullable()
+	if nullable.Int == nil && !nullable.NaN && nullable.InfinityModifier == pgtype.None {
+		nullable.Int = big.NewInt(0)
+	}
+
+	return nullable.AssignTo(dst)
+}
+
+func (dst *Numeric) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Numeric
+	err := nullable.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+
+	dst.setNullable(&nullable)
+	return nil
+}
+
+func (dst *Numeric) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Numeric
+	err := nullable.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	dst.setNullable(&nullable)
+	return nil
+}
+
+func (src Numeric) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if src.isZero() {
+		return nil, nil
+	}
+
+	nullable := src.nullable()
+	return nullable.EncodeText(ci, buf)
+}
+
+func (src Numeric) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if src.isZero() {
+		return nil, nil
+	}
+
+	nullable := src.nullable()
+	return nullable.EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Numeric) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Numeric{}
+		return nil
+	}
+
+	var nullable pgtype.Numeric
+	err := nullable.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	dst.setNullable(&nullable)
+	return nil
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Numeric) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
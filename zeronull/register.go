@@ -0,0 +1,31 @@
+package zeronull
+
+import (
+	"github.com/jackc/pgtype"
+)
+
+// Register replaces the data types in ci for the PostgreSQL types supported by this package with the zeronull
+// types. Afterwards NULL results are scanned into ordinary Go values as the zero value and zero valued arguments are
+// sent as NULL.
+func Register(ci *pgtype.ConnInfo) {
+	ci.RegisterDataType(pgtype.DataType{Value: new(Bool), Name: "bool", OID: pgtype.BoolOID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(BPChar), Name: "bpchar", OID: pgtype.BPCharOID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Bytea), Name: "bytea", OID: pgtype.ByteaOID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Date), Name: "date", OID: pgtype.DateOID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Float4), Name: "float4", OID: pgtype.Float4OID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Float8), Name: "float8", OID: pgtype.Float8OID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Inet), Name: "inet", OID: pgtype.InetOID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Int2), Name: "int2", OID: pgtype.Int2OID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Int4), Name: "int4", OID: pgtype.Int4OID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Int8), Name: "int8", OID: pgtype.Int8OID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Interval), Name: "interval", OID: pgtype.IntervalOID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(JSON), Name: "json", OID: pgtype.JSONOID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(JSONB), Name: "jsonb", OID: pgtype.JSONBOID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Numeric), Name: "numeric", OID: pgtype.NumericOID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Text), Name: "text", OID: pgtype.TextOID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Time), Name: "time", OID: pgtype.TimeOID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Timestamp), Name: "timestamp", OID: pgtype.TimestampOID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Timestamptz), Name: "timestamptz", OID: pgtype.TimestamptzOID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(UUID), Name: "uuid", OID: pgtype.UUIDOID})
+	ci.RegisterDataType(pgtype.DataType{Value: new(Varchar), Name: "varchar", OID: pgtype.VarcharOID})
+}
@@ -0,0 +1,81 @@
+package zeronull_test
+
+import (
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgtype/zeronull"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	zeronull.Register(ci)
+
+	dt, ok := ci.DataTypeForOID(pgtype.Int4OID)
+	require.True(t, ok)
+	require.IsType(t, new(zeronull.Int4), dt.Value)
+
+	var n int32 = 42
+	err := ci.Scan(pgtype.Int4OID, pgtype.BinaryFormatCode, nil, &n)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, n)
+
+	var s string
+	err = ci.Scan(pgtype.TextOID, pgtype.TextFormatCode, []byte("foo"), &s)
+	require.NoError(t, err)
+	require.Equal(t, "foo", s)
+
+	dt, ok = ci.DataTypeForValue("")
+	require.True(t, ok)
+	err = dt.Value.Set("")
+	require.NoError(t, err)
+	buf, err := dt.Value.(pgtype.TextEncoder).EncodeText(ci, nil)
+	require.NoError(t, err)
+	require.Nil(t, buf)
+
+	err = dt.Value.Set("bar")
+	require.NoError(t, err)
+	buf, err = dt.Value.(pgtype.TextEncoder).EncodeText(ci, nil)
+	require.NoError(t, err)
+	require.Equal(t, "bar", string(buf))
+}
+
+func TestValueSetGetAssignTo(t *testing.T) {
+	var i zeronull.Int8
+	err := i.Set(nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, i)
+	require.Nil(t, i.Get())
+
+	err = i.Set(int32(7))
+	require.NoError(t, err)
+	require.EqualValues(t, 7, i)
+	require.Equal(t, int64(7), i.Get())
+
+	var f float64
+	var i32 int32
+	err = i.AssignTo(&i32)
+	require.NoError(t, err)
+	require.EqualValues(t, 7, i32)
+
+	var numeric zeronull.Numeric
+	err = numeric.Set("1.5")
+	require.NoError(t, err)
+	err = numeric.AssignTo(&f)
+	require.NoError(t, err)
+	require.EqualValues(t, 1.5, f)
+
+	err = numeric.Set(numeric.Get())
+	require.NoError(t, err)
+	err = numeric.AssignTo(&f)
+	require.NoError(t, err)
+	require.EqualValues(t, 1.5, f)
+
+	err = numeric.Set(nil)
+	require.NoError(t, err)
+	require.Nil(t, numeric.Get())
+	err = numeric.AssignTo(&f)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, f)
+}
@@ -8,6 +8,42 @@ import (
 
 type Text string
 
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *Text) Set(src interface{}) error {
+	var nullable pgtype.Text
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Text(nullable.String)
+	} else {
+		*dst = Text("")
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src Text) Get() interface{} {
+	if src == Text("") {
+		return nil
+	}
+
+	return string(src)
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *Text) AssignTo(dst interface{}) error {
+	nullable := pgtype.Text{
+		String: string(*src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
 func (dst *Text) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
 	var nullable pgtype.Text
 	err := nullable.DecodeText(ci, src)
@@ -0,0 +1,134 @@
+package zeronull
+
+import (
+	"database/sql/driver"
+
+	"github.com/jackc/pgtype"
+)
+
+// Time is the number of microseconds since midnight where midnight is NULL. See pgtype.Time for why time.Time is not
+// used.
+type Time int64
+
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *Time) Set(src interface{}) error {
+	// pgtype.Time.Set does not accept the microseconds returned by Get.
+	if value, ok := src.(Time); ok {
+		*dst = value
+		return nil
+	}
+
+	var nullable pgtype.Time
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Time(nullable.Microseconds)
+	} else {
+		*dst = 0
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src Time) Get() interface{} {
+	if src == 0 {
+		return nil
+	}
+
+	return int64(src)
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *Time) AssignTo(dst interface{}) error {
+	nullable := pgtype.Time{
+		Microseconds: int64(*src),
+		Status:       pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
+func (dst *Time) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Time
+	err := nullable.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Time(nullable.Microseconds)
+	} else {
+		*dst = 0
+	}
+
+	return nil
+}
+
+func (dst *Time) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Time
+	err := nullable.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Time(nullable.Microseconds)
+	} else {
+		*dst = 0
+	}
+
+	return nil
+}
+
+func (src Time) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if src == 0 {
+		return nil, nil
+	}
+
+	nullable := pgtype.Time{
+		Microseconds: int64(src),
+		Status:       pgtype.Present,
+	}
+
+	return nullable.EncodeText(ci, buf)
+}
+
+func (src Time) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if src == 0 {
+		return nil, nil
+	}
+
+	nullable := pgtype.Time{
+		Microseconds: int64(src),
+		Status:       pgtype.Present,
+	}
+
+	return nullable.EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Time) Scan(src interface{}) error {
+	if src == nil {
+		*dst = 0
+		return nil
+	}
+
+	var nullable pgtype.Time
+	err := nullable.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	*dst = Time(nullable.Microseconds)
+
+	return nil
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Time) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
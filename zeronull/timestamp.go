@@ -9,6 +9,42 @@ import (
 
 type Timestamp time.Time
 
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *Timestamp) Set(src interface{}) error {
+	var nullable pgtype.Timestamp
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Timestamp(nullable.Time)
+	} else {
+		*dst = Timestamp{}
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src Timestamp) Get() interface{} {
+	if (src == Timestamp{}) {
+		return nil
+	}
+
+	return time.Time(src)
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *Timestamp) AssignTo(dst interface{}) error {
+	nullable := pgtype.Timestamp{
+		Time:   time.Time(*src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
 func (dst *Timestamp) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
 	var nullable pgtype.Timestamp
 	err := nullable.DecodeText(ci, src)
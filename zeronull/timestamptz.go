@@ -9,6 +9,42 @@ import (
 
 type Timestamptz time.Time
 
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *Timestamptz) Set(src interface{}) error {
+	var nullable pgtype.Timestamptz
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Timestamptz(nullable.Time)
+	} else {
+		*dst = Timestamptz{}
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src Timestamptz) Get() interface{} {
+	if (src == Timestamptz{}) {
+		return nil
+	}
+
+	return time.Time(src)
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *Timestamptz) AssignTo(dst interface{}) error {
+	nullable := pgtype.Timestamptz{
+		Time:   time.Time(*src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
 func (dst *Timestamptz) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
 	var nullable pgtype.Timestamptz
 	err := nullable.DecodeText(ci, src)
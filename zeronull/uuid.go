@@ -8,6 +8,42 @@ import (
 
 type UUID [16]byte
 
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *UUID) Set(src interface{}) error {
+	var nullable pgtype.UUID
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = UUID(nullable.Bytes)
+	} else {
+		*dst = UUID{}
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src UUID) Get() interface{} {
+	if (src == UUID{}) {
+		return nil
+	}
+
+	return [16]byte(src)
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *UUID) AssignTo(dst interface{}) error {
+	nullable := pgtype.UUID{
+		Bytes:  [16]byte(*src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
 func (dst *UUID) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
 	var nullable pgtype.UUID
 	err := nullable.DecodeText(ci, src)
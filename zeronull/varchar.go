@@ -0,0 +1,126 @@
+package zeronull
+
+import (
+	"database/sql/driver"
+
+	"github.com/jackc/pgtype"
+)
+
+type Varchar string
+
+// Set converts src and stores it in dst. NULL is stored as the zero value.
+func (dst *Varchar) Set(src interface{}) error {
+	var nullable pgtype.Varchar
+	err := nullable.Set(src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Varchar(nullable.String)
+	} else {
+		*dst = Varchar("")
+	}
+
+	return nil
+}
+
+// Get returns nil if src is the zero value.
+func (src Varchar) Get() interface{} {
+	if src == Varchar("") {
+		return nil
+	}
+
+	return string(src)
+}
+
+// AssignTo assigns src to dst. The zero value is assigned as a value rather than as NULL.
+func (src *Varchar) AssignTo(dst interface{}) error {
+	nullable := pgtype.Varchar{
+		String: string(*src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.AssignTo(dst)
+}
+
+func (dst *Varchar) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Varchar
+	err := nullable.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Varchar(nullable.String)
+	} else {
+		*dst = Varchar("")
+	}
+
+	return nil
+}
+
+func (dst *Varchar) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	var nullable pgtype.Varchar
+	err := nullable.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if nullable.Status == pgtype.Present {
+		*dst = Varchar(nullable.String)
+	} else {
+		*dst = Varchar("")
+	}
+
+	return nil
+}
+
+func (src Varchar) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if src == Varchar("") {
+		return nil, nil
+	}
+
+	nullable := pgtype.Varchar{
+		String: string(src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeText(ci, buf)
+}
+
+func (src Varchar) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	if src == Varchar("") {
+		return nil, nil
+	}
+
+	nullable := pgtype.Varchar{
+		String: string(src),
+		Status: pgtype.Present,
+	}
+
+	return nullable.EncodeBinary(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Varchar) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Varchar("")
+		return nil
+	}
+
+	var nullable pgtype.Varchar
+	err := nullable.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	*dst = Varchar(nullable.String)
+
+	return nil
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Varchar) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
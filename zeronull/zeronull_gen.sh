@@ -0,0 +1,17 @@
+erb type_name=Bool go_type=bool value_field=Bool zero=false is_zero='src == false' zeronull.go.erb > bool.go
+erb type_name=BPChar go_type=string value_field=String zero='BPChar("")' is_zero='src == BPChar("")' zeronull.go.erb > bpchar.go
+erb type_name=Bytea go_type='[]byte' value_field=Bytes zero=nil is_zero='len(src) == 0' zeronull.go.erb > bytea.go
+erb type_name=Date go_type=time.Time value_field=Time zero='Date{}' is_zero='(src == Date{})' zeronull.go.erb > date.go
+erb type_name=Float4 go_type=float32 value_field=Float zero=0 is_zero='src == 0' zeronull.go.erb > float4.go
+erb type_name=Float8 go_type=float64 value_field=Float zero=0 is_zero='src == 0' zeronull.go.erb > float8.go
+erb type_name=Int2 go_type=int16 value_field=Int zero=0 is_zero='src == 0' zeronull.go.erb > int2.go
+erb type_name=Int4 go_type=int32 value_field=Int zero=0 is_zero='src == 0' zeronull.go.erb > int4.go
+erb type_name=Int8 go_type=int64 value_field=Int zero=0 is_zero='src == 0' zeronull.go.erb > int8.go
+erb type_name=JSON go_type='[]byte' value_field=Bytes zero=nil is_zero='len(src) == 0' zeronull.go.erb > json.go
+erb type_name=JSONB go_type='[]byte' value_field=Bytes zero=nil is_zero='len(src) == 0' zeronull.go.erb > jsonb.go
+erb type_name=Text go_type=string value_field=String zero='Text("")' is_zero='src == Text("")' zeronull.go.erb > text.go
+erb type_name=Timestamp go_type=time.Time value_field=Time zero='Timestamp{}' is_zero='(src == Timestamp{})' zeronull.go.erb > timestamp.go
+erb type_name=Timestamptz go_type=time.Time value_field=Time zero='Timestamptz{}' is_zero='(src == Timestamptz{})' zeronull.go.erb > timestamptz.go
+erb type_name=UUID go_type='[16]byte' value_field=Bytes zero='UUID{}' is_zero='(src == UUID{})' zeronull.go.erb > uuid.go
+erb type_name=Varchar go_type=string value_field=String zero='Varchar("")' is_zero='src == Varchar("")' zeronull.go.erb > varchar.go
+goimports -w *.go
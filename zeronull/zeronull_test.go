@@ -0,0 +1,73 @@
+package zeronull_test
+
+import (
+	"math/big"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgtype/testutil"
+	"github.com/jackc/pgtype/zeronull"
+)
+
+// TestTypes checks that each type transcodes its values and converts between NULL and its zero value, which is the
+// last of its values.
+func TestTypes(t *testing.T) {
+	tests := []struct {
+		pgTypeName string
+		values     []interface{}
+		eqFunc     func(a, b interface{}) bool
+	}{
+		{pgTypeName: "bool", values: []interface{}{zeronull.Bool(true), zeronull.Bool(false)}},
+		{pgTypeName: "bpchar", values: []interface{}{zeronull.BPChar("foo"), zeronull.BPChar("")}},
+		{pgTypeName: "bytea", values: []interface{}{zeronull.Bytea{1, 2, 3}, zeronull.Bytea(nil)}},
+		{
+			pgTypeName: "date",
+			values:     []interface{}{zeronull.Date(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)), zeronull.Date{}},
+			eqFunc: func(a, b interface{}) bool {
+				return time.Time(a.(zeronull.Date)).Equal(time.Time(b.(zeronull.Date)))
+			},
+		},
+		{pgTypeName: "float4", values: []interface{}{zeronull.Float4(1.5), zeronull.Float4(0)}},
+		{
+			pgTypeName: "inet",
+			values:     []interface{}{zeronull.Inet{IP: net.IPv4(127, 0, 0, 1).To4(), Mask: net.CIDRMask(32, 32)}, zeronull.Inet{}},
+			eqFunc: func(a, b interface{}) bool {
+				an := net.IPNet(a.(zeronull.Inet))
+				bn := net.IPNet(b.(zeronull.Inet))
+				return an.String() == bn.String()
+			},
+		},
+		{
+			pgTypeName: "interval",
+			values: []interface{}{
+				zeronull.Interval{Microseconds: 5400000000},
+				zeronull.Interval{Microseconds: 1, Days: 2, Months: 3},
+				zeronull.Interval{},
+			},
+		},
+		{pgTypeName: "json", values: []interface{}{zeronull.JSON(`{"a":1}`), zeronull.JSON(nil)}},
+		{pgTypeName: "jsonb", values: []interface{}{zeronull.JSONB(`{"a": 1}`), zeronull.JSONB(nil)}},
+		{
+			pgTypeName: "numeric",
+			values:     []interface{}{zeronull.Numeric{Int: big.NewInt(123), Exp: -2}, zeronull.Numeric{NaN: true}, zeronull.Numeric{}},
+		},
+		{pgTypeName: "time", values: []interface{}{zeronull.Time(3600000000), zeronull.Time(0)}},
+		{pgTypeName: "varchar", values: []interface{}{zeronull.Varchar("foo"), zeronull.Varchar("")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pgTypeName, func(t *testing.T) {
+			eqFunc := tt.eqFunc
+			if eqFunc == nil {
+				eqFunc = reflect.DeepEqual
+			}
+			zero := tt.values[len(tt.values)-1]
+
+			testutil.TestSuccessfulTranscodeEqFunc(t, tt.pgTypeName, tt.values, eqFunc)
+			testutil.TestGoZeroToNullConversion(t, tt.pgTypeName, zero)
+			testutil.TestNullToGoZeroConversion(t, tt.pgTypeName, zero)
+		})
+	}
+}